@@ -0,0 +1,90 @@
+package bban
+
+import (
+	"errors"
+	"sync"
+)
+
+// Error codes returned by failures to create a custom character type.
+var (
+	ErrInvalidCharType = errors.New("bban: invalid custom character type")
+)
+
+// firstCustom represents first value of custom character types.
+const firstCustom CharType = 1 << 8
+
+// customCharType holds definition of a custom character type.
+type customCharType struct {
+	name     string
+	notation byte
+	validate func(string) bool
+}
+
+var (
+	customMu    sync.RWMutex
+	customTypes []customCharType
+)
+
+// NewCharType creates a new character type validated by given function.
+// Name must be unique among all character types and notation is the
+// registry character class, one of n, a, c or e, used when Structure is
+// written in structure notation.
+func NewCharType(name string, notation byte, validate func(string) bool) (CharType, error) {
+	if name == "" || validate == nil {
+		return 0, ErrInvalidCharType
+	}
+	if _, ok := notationCharType(notation); !ok {
+		return 0, ErrInvalidCharType
+	}
+
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	if _, ok := lookupBuiltinCharType(name); ok {
+		return 0, ErrInvalidCharType
+	}
+	for _, c := range customTypes {
+		if c.name == name {
+			return 0, ErrInvalidCharType
+		}
+	}
+
+	customTypes = append(customTypes, customCharType{
+		name:     name,
+		notation: notation,
+		validate: validate,
+	})
+	return firstCustom + CharType(len(customTypes)-1), nil
+}
+
+// MustNewCharType creates a new character type, panics on failure.
+func MustNewCharType(name string, notation byte, validate func(string) bool) CharType {
+	c, err := NewCharType(name, notation, validate)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func lookupCustom(c CharType) (customCharType, bool) {
+	customMu.RLock()
+	defer customMu.RUnlock()
+
+	idx := int(c - firstCustom)
+	if c < firstCustom || idx >= len(customTypes) {
+		return customCharType{}, false
+	}
+	return customTypes[idx], true
+}
+
+func lookupCustomName(name string) (CharType, bool) {
+	customMu.RLock()
+	defer customMu.RUnlock()
+
+	for idx, c := range customTypes {
+		if c.name == name {
+			return firstCustom + CharType(idx), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,67 @@
+package bban
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	nonZeroNum = MustNewCharType("NonZeroNum", 'n', func(s string) bool {
+		return Num.Validate(s) && strings.Trim(s, "0") != ""
+	})
+)
+
+func TestCustomCharType(t *testing.T) {
+	require.Equal(t, "NonZeroNum", nonZeroNum.String())
+	require.True(t, nonZeroNum.Validate("0012"))
+	require.False(t, nonZeroNum.Validate("0000"))
+	require.False(t, nonZeroNum.Validate("00A2"))
+	require.False(t, nonZeroNum.Validate(""))
+
+	c, ok := LookupCharType("NonZeroNum")
+	require.True(t, ok)
+	require.Equal(t, nonZeroNum, c)
+
+	part := NewAccountNumber(4, nonZeroNum)
+	require.Equal(t, nonZeroNum, part.CharType())
+	require.Equal(t, "NonZeroNum", part.CharTypeName())
+	require.True(t, part.Validate("1000"))
+
+	st := NewStructure(NewBankCode(4, AlphaUpper), part)
+	require.Equal(t, "4!a4!n", st.Notation())
+	require.Equal(t, byte('n'), nonZeroNum.Class())
+}
+
+func TestNewCharTypeInvalid(t *testing.T) {
+	valid := func(string) bool { return true }
+
+	cases := []struct {
+		name     string
+		notation byte
+		validate func(string) bool
+	}{
+		{"", 'n', valid},
+		{"Custom", 'x', valid},
+		{"Custom", 'n', nil},
+		{"Num", 'n', valid},
+		{"NonZeroNum", 'n', valid},
+	}
+	for _, cs := range cases {
+		_, err := NewCharType(cs.name, cs.notation, cs.validate)
+		require.Equal(t, ErrInvalidCharType, err, cs.name)
+	}
+
+	require.Panics(t, func() {
+		MustNewCharType("", 'n', valid)
+	})
+	require.Equal(t, "", (firstCustom + 1000).String())
+	require.False(t, (firstCustom + 1000).Validate("1"))
+}
+
+func TestPartCharType(t *testing.T) {
+	for _, c := range []CharType{Num, Zero, AlphaUpper, AlphaNum, Space} {
+		require.Equal(t, c, NewBankCode(2, c).CharType())
+	}
+}
@@ -0,0 +1,118 @@
+package bban
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Error codes returned by failures to parse structure notation.
+var (
+	ErrInvalidNotation = errors.New("bban: invalid structure notation")
+	ErrEntryTypeCount  = errors.New("bban: number of entry types does not match structure notation")
+)
+
+const (
+	// notationFixed marks fixed length element of structure notation.
+	notationFixed = '!'
+
+	// notationNum represents digits in structure notation.
+	notationNum = 'n'
+
+	// notationAlphaUpper represents uppercase letters in structure notation.
+	notationAlphaUpper = 'a'
+
+	// notationAlphaNum represents alphanumeric characters in structure notation.
+	notationAlphaNum = 'c'
+
+	// notationSpace represents blank space characters in structure notation.
+	notationSpace = 'e'
+)
+
+// ParseStructure parses bban structure notation used by the IBAN registry,
+// for example 4!a6!n8!c. Character classes n, a, c and e map to Num,
+// AlphaUpper, AlphaNum and Space. Each element of notation becomes a Part with
+// EntryType given at the same position, all Parts are AccountNumber if no
+// entry types are given.
+func ParseStructure(notation string, entries ...EntryType) (Structure, error) {
+	var parts []Part
+	for rest := notation; rest != ""; {
+		idx := strings.IndexByte(rest, notationFixed)
+		if idx <= 0 || idx+1 >= len(rest) {
+			return Structure{}, ErrInvalidNotation
+		}
+		length, err := strconv.Atoi(rest[:idx])
+		if err != nil || length <= 0 {
+			return Structure{}, ErrInvalidNotation
+		}
+		char, ok := notationCharType(rest[idx+1])
+		if !ok {
+			return Structure{}, ErrInvalidNotation
+		}
+		parts = append(parts, NewPart(length, char, AccountNumber))
+		rest = rest[idx+2:]
+	}
+	if len(parts) == 0 {
+		return Structure{}, ErrInvalidNotation
+	}
+
+	if len(entries) > 0 {
+		if len(entries) != len(parts) {
+			return Structure{}, ErrEntryTypeCount
+		}
+		for idx, entry := range entries {
+			parts[idx].EntryType = entry
+		}
+	}
+	return NewStructure(parts...), nil
+}
+
+// Notation returns bban structure notation used by the IBAN registry.
+// Zero character type is written as digits, custom character types are
+// written as their registry character class.
+func (s Structure) Notation() string {
+	var sb strings.Builder
+	for _, p := range s.parts {
+		sb.WriteString(strconv.Itoa(p.Length))
+		sb.WriteByte(notationFixed)
+		sb.WriteByte(p.charType.Class())
+	}
+	return sb.String()
+}
+
+// String returns text representation of Structure in structure notation.
+func (s Structure) String() string {
+	return s.Notation()
+}
+
+// Class returns registry character class of CharType used in structure
+// notation, Zero is written as digits and custom character types as their
+// registry character class.
+func (c CharType) Class() byte {
+	switch c {
+	case AlphaUpper:
+		return notationAlphaUpper
+	case AlphaNum:
+		return notationAlphaNum
+	case Space:
+		return notationSpace
+	}
+	if custom, ok := lookupCustom(c); ok {
+		return custom.notation
+	}
+	return notationNum
+}
+
+func notationCharType(b byte) (CharType, bool) {
+	switch b {
+	case notationNum:
+		return Num, true
+	case notationAlphaUpper:
+		return AlphaUpper, true
+	case notationAlphaNum:
+		return AlphaNum, true
+	case notationSpace:
+		return Space, true
+	}
+	return 0, false
+}
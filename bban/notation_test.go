@@ -0,0 +1,69 @@
+package bban
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	notationTests = []struct {
+		notation string
+		entries  []EntryType
+		parts    []Part
+		err      error
+	}{
+		{"4!n", nil, []Part{NewAccountNumber(4, Num)}, nil},
+		{"4!a6!n8!c", []EntryType{BankCode, BranchCode, AccountNumber}, []Part{
+			NewBankCode(4, AlphaUpper),
+			NewBranchCode(6, Num),
+			NewAccountNumber(8, AlphaNum),
+		}, nil},
+		{"3!n7!n2!n", []EntryType{BankCode, AccountNumber, NationalCheckDigit}, []Part{
+			NewBankCode(3, Num),
+			NewAccountNumber(7, Num),
+			NewNationalCheckDigit(2, Num),
+		}, nil},
+		{"4!c2!e", []EntryType{AccountNumber, Padding}, []Part{
+			NewAccountNumber(4, AlphaNum),
+			NewPadding(2, Space),
+		}, nil},
+		{"4!a10!n", []EntryType{BankCode}, nil, ErrEntryTypeCount},
+		{"", nil, nil, ErrInvalidNotation},
+		{"4n", nil, nil, ErrInvalidNotation},
+		{"4!", nil, nil, ErrInvalidNotation},
+		{"!n", nil, nil, ErrInvalidNotation},
+		{"0!n", nil, nil, ErrInvalidNotation},
+		{"x!n", nil, nil, ErrInvalidNotation},
+		{"4!x", nil, nil, ErrInvalidNotation},
+		{"4!n2", nil, nil, ErrInvalidNotation},
+	}
+)
+
+func TestParseStructure(t *testing.T) {
+	for _, tc := range notationTests {
+		t.Run(tc.notation, func(t *testing.T) {
+			st, err := ParseStructure(tc.notation, tc.entries...)
+			require.Equal(t, tc.err, err)
+			require.Equal(t, tc.parts, st.Parts())
+			if err == nil {
+				require.Equal(t, tc.notation, st.Notation())
+				require.Equal(t, tc.notation, st.String())
+			}
+		})
+	}
+}
+
+func TestStructureNotation(t *testing.T) {
+	st := NewStructure(NewPadding(1, Zero), NewBankCode(3, Num), NewAccountNumber(14, Num))
+	require.Equal(t, "1!n3!n14!n", st.Notation())
+	require.Equal(t, "", NewStructure().Notation())
+}
+
+func TestCharTypeClass(t *testing.T) {
+	require.Equal(t, byte('n'), Num.Class())
+	require.Equal(t, byte('n'), Zero.Class())
+	require.Equal(t, byte('a'), AlphaUpper.Class())
+	require.Equal(t, byte('c'), AlphaNum.Class())
+	require.Equal(t, byte('e'), Space.Class())
+}
@@ -4,7 +4,7 @@ package bban
 type Part struct {
 	Length    int
 	EntryType EntryType
-	charType  charType
+	charType  CharType
 }
 
 // Validate validates given value against part CharType.
@@ -12,13 +12,23 @@ func (p Part) Validate(value string) bool {
 	return p.charType.Validate(value)
 }
 
+// CharType returns character type of Part.
+func (p Part) CharType() CharType {
+	return p.charType
+}
+
+// CharTypeName returns a text representation of Part character type.
+func (p Part) CharTypeName() string {
+	return p.charType.String()
+}
+
 // String returns a text representation of Part.
 func (p Part) String() string {
 	return p.EntryType.String()
 }
 
 // NewPart creates a new Part.
-func NewPart(length int, char charType, entry EntryType) Part {
+func NewPart(length int, char CharType, entry EntryType) Part {
 	return Part{
 		Length:    length,
 		EntryType: entry,
@@ -27,46 +37,51 @@ func NewPart(length int, char charType, entry EntryType) Part {
 }
 
 // NewBankCode creates a new Part with BankCode EntryType.
-func NewBankCode(length int, char charType) Part {
+func NewBankCode(length int, char CharType) Part {
 	return NewPart(length, char, BankCode)
 }
 
 // NewBranchCode creates a new Part with BranchCode EntryType.
-func NewBranchCode(length int, char charType) Part {
+func NewBranchCode(length int, char CharType) Part {
 	return NewPart(length, char, BranchCode)
 }
 
 // NewAccountNumber creates a new Part with AccountNumber EntryType.
-func NewAccountNumber(length int, char charType) Part {
+func NewAccountNumber(length int, char CharType) Part {
 	return NewPart(length, char, AccountNumber)
 }
 
 // NewNationalCheckDigit creates a new Part with NationalCheckDigit EntryType.
-func NewNationalCheckDigit(length int, char charType) Part {
+func NewNationalCheckDigit(length int, char CharType) Part {
 	return NewPart(length, char, NationalCheckDigit)
 }
 
 // NewAccountType creates a new Part with AccountType EntryType.
-func NewAccountType(length int, char charType) Part {
+func NewAccountType(length int, char CharType) Part {
 	return NewPart(length, char, AccountType)
 }
 
 // NewOwnerAccountType creates a new Part with OwnerAccountType EntryType.
-func NewOwnerAccountType(length int, char charType) Part {
+func NewOwnerAccountType(length int, char CharType) Part {
 	return NewPart(length, char, OwnerAccountType)
 }
 
 // NewIdentificationNumber creates a new Part with IdentificationNumber EntryType.
-func NewIdentificationNumber(length int, char charType) Part {
+func NewIdentificationNumber(length int, char CharType) Part {
 	return NewPart(length, char, IdentificationNumber)
 }
 
 // NewCurrency creates a new Part with Currency EntryType.
-func NewCurrency(length int, char charType) Part {
+func NewCurrency(length int, char CharType) Part {
 	return NewPart(length, char, Currency)
 }
 
+// NewAccountNumberPrefix creates a new Part with AccountNumberPrefix EntryType.
+func NewAccountNumberPrefix(length int, char CharType) Part {
+	return NewPart(length, char, AccountNumberPrefix)
+}
+
 // NewPadding creates a new Part with Padding EntryType.
-func NewPadding(length int, char charType) Part {
+func NewPadding(length int, char CharType) Part {
 	return NewPart(length, char, Padding)
 }
@@ -1,10 +1,12 @@
 package bban
 
+import "strings"
+
 // EntryType represents a type of bban part.
 type EntryType int
 
-// charType represents a character type of given bban part.
-type charType int
+// CharType represents a character type of given bban part.
+type CharType int
 
 const (
 	// BankCode represents bank code part of iban.
@@ -34,17 +36,27 @@ const (
 	// Padding represents optional padding of iban.
 	Padding
 
-	// Num allows only numeric characters.
-	Num charType = iota
+	// AccountNumberPrefix represents optional account number prefix part of iban.
+	AccountNumberPrefix
+)
+
+// Character types keep values they had when they were declared together
+// with entry types.
+const (
+	// Num allows only numeric characters, registry class n.
+	Num CharType = iota + 9
 
 	// Zero allows only zero characters.
 	Zero
 
-	// AlphaUpper allows only uppercase alphabetic characters.
+	// AlphaUpper allows only uppercase alphabetic characters, registry class a.
 	AlphaUpper
 
-	// AlphaNum allow only alphanumeric characters with any case.
+	// AlphaNum allow only alphanumeric characters with any case, registry class c.
 	AlphaNum
+
+	// Space allows only blank space characters, registry class e.
+	Space
 )
 
 // String returns text representation of EntryType.
@@ -68,12 +80,69 @@ func (e EntryType) String() string {
 		return "Currency"
 	case Padding:
 		return "Padding"
+	case AccountNumberPrefix:
+		return "AccountNumberPrefix"
+	}
+	return ""
+}
+
+// Optional returns true for entry types which can be left out when bban is
+// composed from parts, their value is filled with zeros. Padding and account
+// number prefix are optional.
+func (e EntryType) Optional() bool {
+	return e == Padding || e == AccountNumberPrefix
+}
+
+// LookupEntryType returns EntryType by its text representation.
+func LookupEntryType(name string) (EntryType, bool) {
+	for e := BankCode; e <= AccountNumberPrefix; e++ {
+		if e.String() == name {
+			return e, true
+		}
+	}
+	return 0, false
+}
+
+// String returns text representation of CharType.
+func (c CharType) String() string {
+	switch c {
+	case Num:
+		return "Num"
+	case Zero:
+		return "Zero"
+	case AlphaUpper:
+		return "AlphaUpper"
+	case AlphaNum:
+		return "AlphaNum"
+	case Space:
+		return "Space"
+	}
+	if custom, ok := lookupCustom(c); ok {
+		return custom.name
 	}
 	return ""
 }
 
+// LookupCharType returns character type by its text representation,
+// custom character types are included.
+func LookupCharType(name string) (CharType, bool) {
+	if c, ok := lookupBuiltinCharType(name); ok {
+		return c, true
+	}
+	return lookupCustomName(name)
+}
+
+func lookupBuiltinCharType(name string) (CharType, bool) {
+	for c := Num; c <= Space; c++ {
+		if c.String() == name {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
 // Validate validates given value against current CharType.
-func (c charType) Validate(s string) bool {
+func (c CharType) Validate(s string) bool {
 	if s == "" {
 		return false
 	}
@@ -86,6 +155,11 @@ func (c charType) Validate(s string) bool {
 		return validateAlphaNum(s)
 	case Zero:
 		return validateZero(s)
+	case Space:
+		return validateSpace(s)
+	}
+	if custom, ok := lookupCustom(c); ok {
+		return custom.validate(s)
 	}
 	return false
 }
@@ -110,7 +184,13 @@ func validateAlphaUpper(s string) bool {
 
 func validateAlphaNum(s string) bool {
 	return validateString(s, func(r rune) bool {
-		return ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')
+		return ('A' <= r && r <= 'Z') || ('a' <= r && r <= 'z') || ('0' <= r && r <= '9')
+	})
+}
+
+func validateSpace(s string) bool {
+	return validateString(s, func(r rune) bool {
+		return r == ' '
 	})
 }
 
@@ -123,9 +203,13 @@ func validateString(s string, validator func(rune) bool) bool {
 	return true
 }
 
+// Checker validates national check digits of bban with given Structure.
+type Checker func(bbn string, struc Structure) bool
+
 // Structure represents a bban structure which consists of bban Parts.
 type Structure struct {
-	parts []Part
+	parts   []Part
+	checker Checker
 }
 
 // Parts returns slice of Parts.
@@ -142,6 +226,43 @@ func (s Structure) Length() int {
 	return length
 }
 
+// Extract returns a value of the first Part with given EntryType from bban,
+// empty string is returned if there is no such Part.
+func (s Structure) Extract(bbn string, entry EntryType) string {
+	var offset int
+	for _, part := range s.Parts() {
+		if offset+part.Length > len(bbn) {
+			break
+		}
+		if part.EntryType == entry {
+			return bbn[offset : offset+part.Length]
+		}
+		offset += part.Length
+	}
+	return ""
+}
+
+// HasChecker returns true if Structure has national Checker.
+func (s Structure) HasChecker() bool {
+	return s.checker != nil
+}
+
+// Check validates national check digits of bban using Structure Checker,
+// bban of Structure without Checker is always valid. Bban is uppercased
+// before it is passed to Checker.
+func (s Structure) Check(bbn string) bool {
+	if s.checker == nil {
+		return true
+	}
+	return s.checker(strings.ToUpper(bbn), s)
+}
+
+// WithChecker returns a copy of Structure with given national Checker.
+func (s Structure) WithChecker(checker Checker) Structure {
+	s.checker = checker
+	return s
+}
+
 // NewStructure creates a new Structure from given Parts.
 func NewStructure(parts ...Part) Structure {
 	return Structure{parts: parts}
@@ -9,7 +9,7 @@ import (
 var (
 	charTypeTests = []struct {
 		in       string
-		charType charType
+		charType CharType
 		want     bool
 	}{
 		{"0123", Num, true},
@@ -25,12 +25,21 @@ var (
 		{"", AlphaUpper, false},
 		{"AB2", AlphaNum, true},
 		{"AB", AlphaNum, true},
+		{"ab2", AlphaNum, true},
+		{"aB2", AlphaNum, true},
+		{"AB-", AlphaNum, false},
+		{"A B", AlphaNum, false},
 		{"", AlphaNum, false},
+		{" ", Space, true},
+		{"   ", Space, true},
+		{" 0", Space, false},
+		{"\t", Space, false},
+		{"", Space, false},
 	}
 	partTests = []struct {
 		length    int
 		entryType EntryType
-		charType  charType
+		charType  CharType
 		val       string
 		want      bool
 	}{
@@ -43,9 +52,10 @@ var (
 		{2, IdentificationNumber, Num, "213", true},
 		{3, Currency, AlphaUpper, "MUR", true},
 		{3, Padding, AlphaNum, "00", true},
+		{6, AccountNumberPrefix, Num, "000019", true},
 	}
 	newPartTests = []struct {
-		new  func(length int, char charType) Part
+		new  func(length int, char CharType) Part
 		want EntryType
 	}{
 		{NewBankCode, BankCode},
@@ -57,6 +67,7 @@ var (
 		{NewIdentificationNumber, IdentificationNumber},
 		{NewCurrency, Currency},
 		{NewPadding, Padding},
+		{NewAccountNumberPrefix, AccountNumberPrefix},
 	}
 )
 
@@ -69,6 +80,53 @@ func TestCharTypeValidate(t *testing.T) {
 	}
 }
 
+func TestCharTypeValues(t *testing.T) {
+	require.Equal(t, CharType(9), Num)
+	require.Equal(t, CharType(10), Zero)
+	require.Equal(t, CharType(11), AlphaUpper)
+	require.Equal(t, CharType(12), AlphaNum)
+	require.Equal(t, CharType(13), Space)
+}
+
+func TestCharTypeString(t *testing.T) {
+	require.Equal(t, "Num", Num.String())
+	require.Equal(t, "Zero", Zero.String())
+	require.Equal(t, "AlphaUpper", AlphaUpper.String())
+	require.Equal(t, "AlphaNum", AlphaNum.String())
+	require.Equal(t, "Space", Space.String())
+	require.Equal(t, "", CharType(-1).String())
+	require.Equal(t, "Num", NewBankCode(3, Num).CharTypeName())
+}
+
+func TestLookupCharType(t *testing.T) {
+	for _, c := range []CharType{Num, Zero, AlphaUpper, AlphaNum, Space} {
+		got, ok := LookupCharType(c.String())
+		require.True(t, ok)
+		require.Equal(t, c, got)
+	}
+
+	_, ok := LookupCharType("Alpha")
+	require.False(t, ok)
+}
+
+func TestLookupEntryType(t *testing.T) {
+	for _, tc := range newPartTests {
+		got, ok := LookupEntryType(tc.want.String())
+		require.True(t, ok)
+		require.Equal(t, tc.want, got)
+	}
+
+	_, ok := LookupEntryType("Iban")
+	require.False(t, ok)
+}
+
+func TestEntryTypeOptional(t *testing.T) {
+	for _, tc := range newPartTests {
+		want := tc.want == Padding || tc.want == AccountNumberPrefix
+		require.Equal(t, want, tc.want.Optional(), tc.want.String())
+	}
+}
+
 func TestPartValidate(t *testing.T) {
 	for _, tc := range partTests {
 		t.Run(tc.val, func(t *testing.T) {
@@ -105,3 +163,32 @@ func TestStructureLength(t *testing.T) {
 	st2 := NewStructure(Part{Length: 2}, Part{Length: 4})
 	require.Equal(t, 6, st2.Length())
 }
+
+func TestStructureExtract(t *testing.T) {
+	st := NewStructure(NewBankCode(3, Num), NewAccountNumber(7, Num), NewNationalCheckDigit(2, Num))
+	require.Equal(t, "539", st.Extract("539007547034", BankCode))
+	require.Equal(t, "0075470", st.Extract("539007547034", AccountNumber))
+	require.Equal(t, "34", st.Extract("539007547034", NationalCheckDigit))
+	require.Equal(t, "", st.Extract("539007547034", BranchCode))
+	require.Equal(t, "", st.Extract("5390", NationalCheckDigit))
+}
+
+func TestStructureCheck(t *testing.T) {
+	st := NewStructure(NewBankCode(3, Num), NewNationalCheckDigit(1, Num))
+	require.False(t, st.HasChecker())
+	require.True(t, st.Check("1234"))
+
+	checked := st.WithChecker(func(bbn string, struc Structure) bool {
+		return struc.Extract(bbn, NationalCheckDigit) == "4"
+	})
+	require.False(t, st.HasChecker())
+	require.True(t, checked.HasChecker())
+	require.True(t, checked.Check("1234"))
+	require.False(t, checked.Check("1235"))
+
+	upper := st.WithChecker(func(bbn string, struc Structure) bool {
+		return bbn == "ABC4"
+	})
+	require.True(t, upper.Check("abc4"))
+	require.Equal(t, st.Length(), checked.Length())
+}
@@ -0,0 +1,543 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"text/template"
+	"time"
+
+	"github.com/jbub/banking/bban"
+	"github.com/jbub/banking/national"
+)
+
+// Error codes returned by failures to generate countries.
+var (
+	errStructureLength  = errors.New("ibangen: bban structure does not match bban length")
+	errOverlayLength    = errors.New("ibangen: overlay parts do not match bban length")
+	errOverlayCharType  = errors.New("ibangen: overlay part char type does not match bban structure")
+	errOverlayNotListed = errors.New("ibangen: overlay country is not present in registry")
+	errInvalidOverlay   = errors.New("ibangen: invalid overlay entry")
+	errInvalidDate      = errors.New("ibangen: invalid effective date")
+	errUnknownChecker   = errors.New("ibangen: unknown national checker")
+	errMissingExample   = errors.New("ibangen: example is missing")
+	errExampleCountry   = errors.New("ibangen: example does not start with country code")
+	errExampleLength    = errors.New("ibangen: example does not match bban length")
+	errExamplePart      = errors.New("ibangen: example does not match bban structure")
+	errExampleModulo    = errors.New("ibangen: example fails modulo 97 check")
+	errExampleNational  = errors.New("ibangen: example fails national check digit validation")
+)
+
+// overlayFile holds data not published in IBAN registry, entry types of
+// bban parts, alpha-3 codes and national check digit validators. Its types
+// follow registry file of package country and are copied here so that
+// generator does not depend on the package it generates.
+type overlayFile struct {
+	Countries []overlayCountry `json:"countries"`
+}
+
+// overlayCountry represents country entry of overlay file, name overrides
+// registry name of the country.
+type overlayCountry struct {
+	Name       string `json:"name"`
+	Alpha2Code string `json:"alpha2"`
+	Alpha3Code string `json:"alpha3"`
+	Checker    string `json:"checker"`
+	overlayStructure
+	Revisions []overlayStructure `json:"revisions"`
+}
+
+// overlayStructure represents bban structure of overlay country given
+// either by parts or by registry structure notation and entry types.
+// Structure of a country given only by entry types uses notation of the
+// registry, parts are given only where char types are narrower than the
+// registry notation.
+type overlayStructure struct {
+	Parts         []overlayPart `json:"parts"`
+	Bban          string        `json:"bban"`
+	EntryTypes    []string      `json:"entry_types"`
+	EffectiveFrom string        `json:"effective_from"`
+	EffectiveTo   string        `json:"effective_to"`
+}
+
+// overlayPart represents bban part of overlay country.
+type overlayPart struct {
+	Length    int    `json:"length"`
+	CharType  string `json:"char_type"`
+	EntryType string `json:"entry_type"`
+}
+
+// genCountry holds data of a generated country.
+type genCountry struct {
+	Name          string
+	Alpha2Code    string
+	Alpha3Code    string
+	Parts         []bban.Part
+	Checker       string
+	Example       string
+	EffectiveFrom time.Time
+	EffectiveTo   time.Time
+	Revisions     []genRevision
+}
+
+// genRevision holds data of a generated previous bban structure.
+type genRevision struct {
+	Parts         []bban.Part
+	EffectiveFrom time.Time
+	EffectiveTo   time.Time
+}
+
+// compatible lists char types allowed in overlay for char types of
+// registry bban structure.
+var compatible = map[string][]string{
+	bban.Num.String():        {bban.Num.String(), bban.Zero.String()},
+	bban.AlphaUpper.String(): {bban.AlphaUpper.String()},
+	bban.AlphaNum.String():   {bban.AlphaNum.String(), bban.AlphaUpper.String(), bban.Num.String(), bban.Zero.String()},
+	bban.Space.String():      {bban.Space.String()},
+}
+
+func loadOverlay(r io.Reader) (overlayFile, error) {
+	var f overlayFile
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return overlayFile{}, err
+	}
+	return f, nil
+}
+
+// byCode returns overlay countries keyed by alpha-2 code.
+func (f overlayFile) byCode() map[string]overlayCountry {
+	overlay := make(map[string]overlayCountry, len(f.Countries))
+	for _, c := range f.Countries {
+		overlay[c.Alpha2Code] = c
+	}
+	return overlay
+}
+
+// build merges registry entries with overlay and checks that registry
+// example of each country validates against its generated structure.
+func build(entries []entry, overlay map[string]overlayCountry) ([]genCountry, error) {
+	listed := make(map[string]bool, len(entries))
+	countries := make([]genCountry, 0, len(entries))
+	for _, e := range entries {
+		listed[e.countryCode] = true
+
+		c, err := buildCountry(e, overlay)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", e.countryCode, err)
+		}
+		if err := checkExample(c); err != nil {
+			return nil, fmt.Errorf("%s: example %s: %w", e.countryCode, c.Example, err)
+		}
+		countries = append(countries, c)
+	}
+
+	for code := range overlay {
+		if !listed[code] {
+			return nil, fmt.Errorf("%s: %w", code, errOverlayNotListed)
+		}
+	}
+
+	sortCountries(countries)
+	return countries, nil
+}
+
+func sortCountries(countries []genCountry) {
+	sort.Slice(countries, func(i, j int) bool {
+		return countries[i].Name < countries[j].Name
+	})
+}
+
+func buildCountry(e entry, overlay map[string]overlayCountry) (genCountry, error) {
+	if e.example == "" {
+		return genCountry{}, errMissingExample
+	}
+
+	struc, err := bban.ParseStructure(e.bbanStructure)
+	if err != nil {
+		return genCountry{}, err
+	}
+
+	var chars []string
+	for _, p := range struc.Parts() {
+		for idx := 0; idx < p.Length; idx++ {
+			chars = append(chars, p.CharTypeName())
+		}
+	}
+	if len(chars) != e.bbanLength {
+		return genCountry{}, errStructureLength
+	}
+
+	effective, err := parseRegistryDate(e.effectiveDate)
+	if err != nil {
+		return genCountry{}, err
+	}
+
+	c := genCountry{
+		Name:          e.name,
+		Alpha2Code:    e.countryCode,
+		Example:       e.example,
+		EffectiveFrom: effective,
+	}
+
+	o, ok := overlay[e.countryCode]
+	if !ok {
+		c.Parts = registryParts(e, chars)
+		return c, nil
+	}
+
+	if o.Checker != "" {
+		if _, ok := national.LookupChecker(o.Checker); !ok {
+			return genCountry{}, errUnknownChecker
+		}
+	}
+	if o.Name != "" {
+		c.Name = o.Name
+	}
+	c.Alpha3Code = o.Alpha3Code
+	c.Checker = o.Checker
+
+	if len(o.Parts) == 0 && o.Bban == "" {
+		o.Bban = e.bbanStructure
+	}
+	if c.Parts, err = o.overlayStructure.parts(); err != nil {
+		return genCountry{}, err
+	}
+	if err := checkOverlayParts(c.Parts, chars); err != nil {
+		return genCountry{}, err
+	}
+
+	from, to, err := o.overlayStructure.dates()
+	if err != nil {
+		return genCountry{}, err
+	}
+	if !from.IsZero() {
+		c.EffectiveFrom = from
+	}
+	c.EffectiveTo = to
+
+	for _, fs := range o.Revisions {
+		var rev genRevision
+		if rev.Parts, err = fs.parts(); err != nil {
+			return genCountry{}, err
+		}
+		if rev.EffectiveFrom, rev.EffectiveTo, err = fs.dates(); err != nil {
+			return genCountry{}, err
+		}
+		c.Revisions = append(c.Revisions, rev)
+	}
+	return c, nil
+}
+
+// parts returns bban parts described by overlay structure.
+func (s overlayStructure) parts() ([]bban.Part, error) {
+	switch {
+	case len(s.Parts) > 0 && s.Bban == "":
+		parts := make([]bban.Part, 0, len(s.Parts))
+		for _, p := range s.Parts {
+			char, ok := bban.LookupCharType(p.CharType)
+			if !ok {
+				return nil, errInvalidOverlay
+			}
+			entry, ok := bban.LookupEntryType(p.EntryType)
+			if !ok || p.Length <= 0 {
+				return nil, errInvalidOverlay
+			}
+			parts = append(parts, bban.NewPart(p.Length, char, entry))
+		}
+		return parts, nil
+	case len(s.Parts) == 0 && s.Bban != "":
+		entries := make([]bban.EntryType, 0, len(s.EntryTypes))
+		for _, name := range s.EntryTypes {
+			entry, ok := bban.LookupEntryType(name)
+			if !ok {
+				return nil, errInvalidOverlay
+			}
+			entries = append(entries, entry)
+		}
+		struc, err := bban.ParseStructure(s.Bban, entries...)
+		if err != nil {
+			return nil, err
+		}
+		return struc.Parts(), nil
+	}
+	return nil, errInvalidOverlay
+}
+
+// dates returns effective dates of overlay structure.
+func (s overlayStructure) dates() (time.Time, time.Time, error) {
+	from, err := parseDate(s.EffectiveFrom)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := parseDate(s.EffectiveTo)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return from, to, nil
+}
+
+// parseDate parses overlay effective date, empty date is zero time.
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, errInvalidDate
+	}
+	return t, nil
+}
+
+// parseRegistryDate parses registry effective date like Jul-07 as the
+// first day of month. Empty date and date of the first registry release are
+// zero time, countries of the first release are effective since they joined
+// the registry.
+func parseRegistryDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(registryDateLayout, value)
+	if err != nil {
+		return time.Time{}, errInvalidDate
+	}
+	if t.Equal(firstRelease) {
+		return time.Time{}, nil
+	}
+	return t, nil
+}
+
+// registryParts splits bban into bank code, branch code and account
+// number parts using registry identifier positions and char types.
+func registryParts(e entry, chars []string) []bban.Part {
+	var parts []bban.Part
+	for idx, char := range chars {
+		entryType := bban.AccountNumber
+		switch offset := idx + 1; {
+		case e.bankPosition.contains(offset):
+			entryType = bban.BankCode
+		case e.branchPosition.contains(offset):
+			entryType = bban.BranchCode
+		}
+
+		charType, _ := bban.LookupCharType(char)
+		if n := len(parts); n > 0 && parts[n-1].EntryType == entryType && parts[n-1].CharTypeName() == char {
+			parts[n-1].Length++
+			continue
+		}
+		parts = append(parts, bban.NewPart(1, charType, entryType))
+	}
+	return parts
+}
+
+// checkOverlayParts checks overlay parts against registry char types.
+func checkOverlayParts(parts []bban.Part, chars []string) error {
+	var offset int
+	for _, p := range parts {
+		if offset+p.Length > len(chars) {
+			return errOverlayLength
+		}
+		for _, char := range chars[offset : offset+p.Length] {
+			if !contains(compatible[char], p.CharTypeName()) {
+				return errOverlayCharType
+			}
+		}
+		offset += p.Length
+	}
+	if offset != len(chars) {
+		return errOverlayLength
+	}
+	return nil
+}
+
+// checkExample validates example iban against country structure, it
+// repeats validation of package iban which is generated from its output.
+func checkExample(c genCountry) error {
+	if len(c.Example) < 4 || c.Example[:2] != c.Alpha2Code {
+		return errExampleCountry
+	}
+
+	struc := bban.NewStructure(c.Parts...)
+	bbn := c.Example[4:]
+	if len(bbn) != struc.Length() {
+		return errExampleLength
+	}
+
+	var offset int
+	for _, p := range c.Parts {
+		if !p.Validate(bbn[offset : offset+p.Length]) {
+			return errExamplePart
+		}
+		offset += p.Length
+	}
+
+	if mod, ok := national.Mod97(bbn + c.Example[:4]); !ok || mod != 1 {
+		return errExampleModulo
+	}
+
+	if c.Checker != "" {
+		checker, ok := national.LookupChecker(c.Checker)
+		if !ok {
+			return errUnknownChecker
+		}
+		if !struc.WithChecker(checker).Check(bbn) {
+			return errExampleNational
+		}
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// dateLayout represents layout of effective dates in overlay file.
+	dateLayout = "2006-01-02"
+
+	// registryDateLayout represents layout of effective dates in registry.
+	registryDateLayout = "Jan-06"
+)
+
+// firstRelease represents effective date of the first registry release.
+var firstRelease = time.Date(2007, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+var funcs = template.FuncMap{
+	"date": func(t time.Time) string {
+		return fmt.Sprintf("time.Date(%d, time.%s, %d, 0, 0, 0, 0, time.UTC)", t.Year(), t.Month(), t.Day())
+	},
+}
+
+var dataTemplate = template.Must(template.New("data").Funcs(funcs).Parse(`// Code generated by ibangen from IBAN registry{{if .Release}} release {{.Release}}{{end}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- if .HasDates}}
+	"time"
+{{end}}
+	"github.com/jbub/banking/bban"
+{{- if .HasChecker}}
+	"github.com/jbub/banking/national"
+{{- end}}
+)
+
+var (
+	countries = map[string]Country{
+{{- range .Countries}}
+		"{{.Alpha2Code}}": {
+			Name:       {{printf "%q" .Name}},
+			Alpha2Code: "{{.Alpha2Code}}",
+			Alpha3Code: "{{.Alpha3Code}}",
+			Structure: bban.NewStructure(
+{{- range .Parts}}
+				bban.New{{.EntryType}}({{.Length}}, bban.{{.CharTypeName}}),
+{{- end}}
+			){{if .Checker}}.WithChecker(national.{{.Checker}}){{end}},
+{{- if not .EffectiveFrom.IsZero}}
+			EffectiveFrom: {{date .EffectiveFrom}},
+{{- end}}
+{{- if not .EffectiveTo.IsZero}}
+			EffectiveTo: {{date .EffectiveTo}},
+{{- end}}
+{{- if .Revisions}}
+			Revisions: []Revision{
+{{- range .Revisions}}
+				{
+					Structure: bban.NewStructure(
+{{- range .Parts}}
+						bban.New{{.EntryType}}({{.Length}}, bban.{{.CharTypeName}}),
+{{- end}}
+					),
+{{- if not .EffectiveFrom.IsZero}}
+					EffectiveFrom: {{date .EffectiveFrom}},
+{{- end}}
+{{- if not .EffectiveTo.IsZero}}
+					EffectiveTo: {{date .EffectiveTo}},
+{{- end}}
+				},
+{{- end}}
+			},
+{{- end}}
+		},
+{{- end}}
+	}
+)
+{{- if .Release}}
+
+// RegistryRelease represents IBAN registry release country data is
+// generated from.
+const RegistryRelease = "{{.Release}}"
+{{- end}}
+`))
+
+var examplesTemplate = template.Must(template.New("examples").Parse(`// Code generated by ibangen from IBAN registry{{if .Release}} release {{.Release}}{{end}}. DO NOT EDIT.
+
+package iban
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	registryExamples = map[string]string{
+{{- range .Countries}}
+		"{{.Alpha2Code}}": "{{.Example}}",
+{{- end}}
+	}
+)
+
+func TestRegistryExamples(t *testing.T) {
+	for code, example := range registryExamples {
+		t.Run(code, func(t *testing.T) {
+			ibn, err := Parse(example)
+			require.NoError(t, err)
+			require.Equal(t, code, ibn.CountryCode())
+		})
+	}
+}
+`))
+
+type templateData struct {
+	Package   string
+	Release   string
+	Countries []genCountry
+}
+
+// HasChecker returns true if any country has national check digit validator.
+func (d templateData) HasChecker() bool {
+	for _, c := range d.Countries {
+		if c.Checker != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDates returns true if any country has effective dates or revisions.
+func (d templateData) HasDates() bool {
+	for _, c := range d.Countries {
+		if !c.EffectiveFrom.IsZero() || !c.EffectiveTo.IsZero() || len(c.Revisions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func render(tmpl *template.Template, data templateData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,97 @@
+// Command ibangen generates country data from the tab separated text
+// release of the SWIFT IBAN registry merged with an overlay file.
+//
+// Names, bban structures, lengths, identifier positions, examples and
+// effective dates are taken from the registry. Entry types of bban parts
+// not published in the registry, alpha-3 codes and national check digit
+// validators are read from the overlay file, countries not present in
+// overlay are split into bank code, branch code and account number parts.
+// Overlay can also override registry names and list previous bban
+// structures with their effective dates.
+//
+// Example of each country must validate against its generated structure.
+//
+// Usage:
+//
+//	ibangen -registry iban_registry.txt -release 98 -overlay overlay.json -o data.go -examples examples_test.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/template"
+)
+
+func main() {
+	var (
+		registryPath = flag.String("registry", "", "path to IBAN registry text release")
+		overlayPath  = flag.String("overlay", "", "path to overlay file")
+		outputPath   = flag.String("o", "data.go", "path to generated country data")
+		examplesPath = flag.String("examples", "", "path to generated iban example tests")
+		release      = flag.String("release", "", "IBAN registry release")
+		pkg          = flag.String("package", "country", "package of generated country data")
+	)
+	flag.Parse()
+
+	if *registryPath == "" {
+		fmt.Fprintln(os.Stderr, "ibangen: registry path is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*registryPath, *overlayPath, *outputPath, *examplesPath, templateData{Package: *pkg, Release: *release}); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(registryPath, overlayPath, outputPath, examplesPath string, data templateData) error {
+	var overlay overlayFile
+	if overlayPath != "" {
+		o, err := os.Open(overlayPath)
+		if err != nil {
+			return err
+		}
+		defer o.Close()
+
+		if overlay, err = loadOverlay(o); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if data.Countries, err = buildRegistry(registryPath, overlay); err != nil {
+		return err
+	}
+
+	if err := write(outputPath, dataTemplate, data); err != nil {
+		return err
+	}
+	if examplesPath != "" {
+		return write(examplesPath, examplesTemplate, data)
+	}
+	return nil
+}
+
+func buildRegistry(path string, overlay overlayFile) ([]genCountry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entries, err := parseRegistry(f)
+	if err != nil {
+		return nil, err
+	}
+	return build(entries, overlay.byCode())
+}
+
+func write(path string, tmpl *template.Template, data templateData) error {
+	src, err := render(tmpl, data)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, src, 0o644)
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/bban"
+)
+
+var (
+	positionCases = []struct {
+		value    string
+		position position
+		err      error
+	}{
+		{"1-4", position{1, 4}, nil},
+		{"5 - 10", position{5, 10}, nil},
+		{"N/A", position{}, nil},
+		{"", position{}, nil},
+		{"4", position{}, errInvalidPosition},
+		{"4-1", position{}, errInvalidPosition},
+		{"0-1", position{}, errInvalidPosition},
+		{"a-4", position{}, errInvalidPosition},
+	}
+)
+
+func TestParsePosition(t *testing.T) {
+	for _, cs := range positionCases {
+		t.Run(cs.value, func(t *testing.T) {
+			pos, err := parsePosition(cs.value)
+			require.Equal(t, cs.err, err)
+			require.Equal(t, cs.position, pos)
+		})
+	}
+}
+
+func TestParseRegistry(t *testing.T) {
+	entries := loadRegistry(t)
+	require.Len(t, entries, 5)
+	require.Equal(t, entry{
+		name:           "United Kingdom",
+		countryCode:    "GB",
+		bbanStructure:  "4!a6!n8!n",
+		bbanLength:     18,
+		bankPosition:   position{1, 4},
+		branchPosition: position{5, 10},
+		example:        "GB29NWBK60161331926819",
+		effectiveDate:  "Apr-07",
+	}, entries[3])
+}
+
+func TestParseRegistryInvalid(t *testing.T) {
+	_, err := parseRegistry(strings.NewReader("Name of country\tAndorra\n"))
+	require.Equal(t, errMissingRow, err)
+}
+
+func TestBuild(t *testing.T) {
+	countries, err := build(loadRegistry(t), loadTestOverlay(t))
+	require.NoError(t, err)
+	require.Len(t, countries, 5)
+
+	ad := countries[0]
+	require.Equal(t, "AD", ad.Alpha2Code)
+	require.Equal(t, []bban.Part{
+		bban.NewBankCode(4, bban.Num),
+		bban.NewBranchCode(4, bban.Num),
+		bban.NewAccountNumber(12, bban.AlphaNum),
+	}, ad.Parts)
+
+	be := countries[1]
+	require.Equal(t, "BEL", be.Alpha3Code)
+	require.Equal(t, "CheckBelgium", be.Checker)
+	require.Equal(t, []bban.Part{
+		bban.NewBankCode(3, bban.Num),
+		bban.NewAccountNumber(7, bban.Num),
+		bban.NewNationalCheckDigit(2, bban.Num),
+	}, be.Parts)
+
+	nl := countries[3]
+	require.Equal(t, "Netherlands", nl.Name)
+	require.True(t, nl.EffectiveFrom.IsZero())
+}
+
+func TestBuildEffectiveDate(t *testing.T) {
+	entries := loadRegistry(t)
+	entries[1].effectiveDate = "Jul-07"
+
+	countries, err := build(entries, loadTestOverlay(t))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2007, time.July, 1, 0, 0, 0, 0, time.UTC), countries[1].EffectiveFrom)
+	require.True(t, countries[0].EffectiveFrom.IsZero())
+
+	entries[1].effectiveDate = "07/2007"
+	_, err = build(entries, nil)
+	require.True(t, errors.Is(err, errInvalidDate))
+}
+
+func TestBuildInvalid(t *testing.T) {
+	entries := loadRegistry(t)
+
+	invalid := append([]entry(nil), entries...)
+	invalid[1].example = "BE68539007547035"
+	_, err := build(invalid, nil)
+	require.ErrorContains(t, err, "BE: example BE68539007547035")
+
+	invalid = append([]entry(nil), entries...)
+	invalid[1].bbanStructure = "3!n7!n2!x"
+	_, err = build(invalid, nil)
+	require.True(t, errors.Is(err, bban.ErrInvalidNotation))
+
+	invalid = append([]entry(nil), entries...)
+	invalid[1].bbanLength = 13
+	_, err = build(invalid, nil)
+	require.True(t, errors.Is(err, errStructureLength))
+
+	overlay := loadTestOverlay(t)
+	gb := overlay["GB"]
+	gb.Parts = gb.Parts[:2]
+	overlay["GB"] = gb
+	_, err = build(entries, overlay)
+	require.True(t, errors.Is(err, errOverlayLength))
+
+	overlay = loadTestOverlay(t)
+	nl := overlay["NL"]
+	nl.Parts = []overlayPart{
+		{Length: 4, CharType: "Num", EntryType: "BankCode"},
+		{Length: 10, CharType: "Num", EntryType: "AccountNumber"},
+	}
+	overlay["NL"] = nl
+	_, err = build(entries, overlay)
+	require.True(t, errors.Is(err, errOverlayCharType))
+
+	overlay = loadTestOverlay(t)
+	be := overlay["BE"]
+	be.EntryTypes = be.EntryTypes[:2]
+	overlay["BE"] = be
+	_, err = build(entries, overlay)
+	require.True(t, errors.Is(err, bban.ErrEntryTypeCount))
+
+	overlay = loadTestOverlay(t)
+	be = overlay["BE"]
+	be.Checker = "CheckMars"
+	overlay["BE"] = be
+	_, err = build(entries, overlay)
+	require.True(t, errors.Is(err, errUnknownChecker))
+
+	invalid = append([]entry(nil), entries...)
+	invalid[1].example = ""
+	_, err = build(invalid, nil)
+	require.True(t, errors.Is(err, errMissingExample))
+
+	overlay = loadTestOverlay(t)
+	overlay["XT"] = overlayCountry{}
+	_, err = build(entries, overlay)
+	require.True(t, errors.Is(err, errOverlayNotListed))
+}
+
+func TestCheckExample(t *testing.T) {
+	cases := []struct {
+		name    string
+		example string
+		checker string
+		part    bban.Part
+		err     error
+	}{
+		{"valid", "BE68539007547034", "CheckBelgium", bban.NewBankCode(3, bban.Num), nil},
+		{"country", "NL68539007547034", "", bban.NewBankCode(3, bban.Num), errExampleCountry},
+		{"length", "BE6853900754703", "", bban.NewBankCode(3, bban.Num), errExampleLength},
+		{"part", "BE68539007547034", "", bban.NewBankCode(3, bban.AlphaUpper), errExamplePart},
+		{"modulo", "BE68539007547043", "", bban.NewBankCode(3, bban.Num), errExampleModulo},
+		{"checker", "BE68539007547034", "CheckMars", bban.NewBankCode(3, bban.Num), errUnknownChecker},
+		{"national", "BE19539007547043", "CheckBelgium", bban.NewBankCode(3, bban.Num), errExampleNational},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name, func(t *testing.T) {
+			err := checkExample(genCountry{
+				Alpha2Code: "BE",
+				Example:    cs.example,
+				Checker:    cs.checker,
+				Parts: []bban.Part{
+					cs.part,
+					bban.NewAccountNumber(7, bban.Num),
+					bban.NewNationalCheckDigit(2, bban.Num),
+				},
+			})
+			require.True(t, errors.Is(err, cs.err), err)
+		})
+	}
+}
+
+func TestGeneratedData(t *testing.T) {
+	dir := t.TempDir()
+	data := filepath.Join(dir, "data.go")
+	examples := filepath.Join(dir, "examples_test.go")
+
+	err := run("../../country/iban_registry.txt", "../../country/overlay.json", data, examples, templateData{Package: "country", Release: "98"})
+	require.NoError(t, err)
+	requireGolden(t, "../../country/data.go", data)
+	requireGolden(t, "../../iban/examples_test.go", examples)
+}
+
+func TestBuildRevisions(t *testing.T) {
+	overlay := loadTestOverlay(t)
+	be := overlay["BE"]
+	be.EffectiveFrom = "2010-01-01"
+	be.Revisions = []overlayStructure{{
+		Bban:          "3!n9!n",
+		EntryTypes:    []string{"BankCode", "AccountNumber"},
+		EffectiveTo:   "2010-01-01",
+		EffectiveFrom: "2001-02-03",
+	}}
+	overlay["BE"] = be
+
+	countries, err := build(loadRegistry(t), overlay)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC), countries[1].EffectiveFrom)
+	require.Equal(t, []genRevision{{
+		Parts:         []bban.Part{bban.NewBankCode(3, bban.Num), bban.NewAccountNumber(9, bban.Num)},
+		EffectiveFrom: time.Date(2001, time.February, 3, 0, 0, 0, 0, time.UTC),
+		EffectiveTo:   time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}}, countries[1].Revisions)
+
+	out, err := render(dataTemplate, templateData{Package: "country", Release: "98", Countries: countries})
+	require.NoError(t, err)
+	require.Contains(t, string(out), "\t\"time\"\n")
+	require.Contains(t, string(out), "EffectiveFrom: time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC),")
+	require.Contains(t, string(out), "bban.NewAccountNumber(9, bban.Num),")
+
+	be.EffectiveTo = "2010/01/01"
+	overlay["BE"] = be
+	_, err = build(loadRegistry(t), overlay)
+	require.Error(t, err)
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	data := filepath.Join(dir, "data.go")
+	examples := filepath.Join(dir, "examples_test.go")
+
+	err := run("testdata/registry.txt", "testdata/overlay.json", data, examples, templateData{Package: "country", Release: "98"})
+	require.NoError(t, err)
+	requireGolden(t, "testdata/data.golden", data)
+	requireGolden(t, "testdata/examples.golden", examples)
+}
+
+func loadRegistry(t *testing.T) []entry {
+	f, err := os.Open("testdata/registry.txt")
+	require.NoError(t, err)
+	defer f.Close()
+
+	entries, err := parseRegistry(f)
+	require.NoError(t, err)
+	return entries
+}
+
+func loadTestOverlay(t *testing.T) map[string]overlayCountry {
+	return loadOverlayFile(t, "testdata/overlay.json").byCode()
+}
+
+func loadOverlayFile(t *testing.T, path string) overlayFile {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	overlay, err := loadOverlay(f)
+	require.NoError(t, err)
+	return overlay
+}
+
+func requireGolden(t *testing.T, golden string, path string) {
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got))
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Error codes returned by failures to parse registry.
+var (
+	errMissingRow      = errors.New("ibangen: registry row is missing")
+	errInvalidPosition = errors.New("ibangen: invalid identifier position")
+	errInvalidLength   = errors.New("ibangen: invalid bban length")
+)
+
+// Labels of registry rows used by generator.
+const (
+	rowName           = "Name of country"
+	rowCountryCode    = "IBAN prefix country code (ISO 3166)"
+	rowBbanStructure  = "BBAN structure"
+	rowBbanLength     = "BBAN length"
+	rowBankPosition   = "Bank identifier position within the BBAN"
+	rowBranchPosition = "Branch identifier position within the BBAN"
+	rowIbanExample    = "IBAN electronic format example"
+	rowEffectiveDate  = "Effective date"
+)
+
+// entry holds registry data of a single country.
+type entry struct {
+	name           string
+	countryCode    string
+	bbanStructure  string
+	bbanLength     int
+	bankPosition   position
+	branchPosition position
+	example        string
+	effectiveDate  string
+}
+
+// position represents 1-based inclusive range of characters within bban,
+// zero position means the identifier is not present.
+type position struct {
+	start int
+	end   int
+}
+
+// parseRegistry parses tab separated text release of IBAN registry. The
+// registry is transposed, each row holds a single data element with the
+// label in the first column and values of countries in next columns.
+func parseRegistry(r io.Reader) ([]entry, error) {
+	rows := make(map[string][]string)
+	var columns int
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		label := normalizeLabel(fields[0])
+		if _, ok := rows[label]; ok {
+			continue
+		}
+		rows[label] = fields[1:]
+		if label == rowCountryCode {
+			columns = len(fields) - 1
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, label := range []string{rowName, rowCountryCode, rowBbanStructure, rowBbanLength, rowBankPosition, rowBranchPosition, rowIbanExample} {
+		if _, ok := rows[label]; !ok {
+			return nil, errMissingRow
+		}
+	}
+
+	entries := make([]entry, 0, columns)
+	for idx := 0; idx < columns; idx++ {
+		value := func(label string) string {
+			row := rows[label]
+			if idx >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[idx])
+		}
+
+		code := value(rowCountryCode)
+		if code == "" {
+			continue
+		}
+
+		length, err := strconv.Atoi(value(rowBbanLength))
+		if err != nil {
+			return nil, errInvalidLength
+		}
+		bank, err := parsePosition(value(rowBankPosition))
+		if err != nil {
+			return nil, err
+		}
+		branch, err := parsePosition(value(rowBranchPosition))
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry{
+			name:           value(rowName),
+			countryCode:    code,
+			bbanStructure:  value(rowBbanStructure),
+			bbanLength:     length,
+			bankPosition:   bank,
+			branchPosition: branch,
+			example:        strings.ReplaceAll(value(rowIbanExample), " ", ""),
+			effectiveDate:  value(rowEffectiveDate),
+		})
+	}
+	return entries, nil
+}
+
+// parsePosition parses identifier position like 1-4, N/A and empty
+// values represent missing identifier.
+func parsePosition(value string) (position, error) {
+	if value == "" || strings.EqualFold(value, "N/A") {
+		return position{}, nil
+	}
+
+	start, end, ok := strings.Cut(value, "-")
+	if !ok {
+		return position{}, errInvalidPosition
+	}
+	s, err := strconv.Atoi(strings.TrimSpace(start))
+	if err != nil {
+		return position{}, errInvalidPosition
+	}
+	e, err := strconv.Atoi(strings.TrimSpace(end))
+	if err != nil || s <= 0 || e < s {
+		return position{}, errInvalidPosition
+	}
+	return position{start: s, end: e}, nil
+}
+
+func (p position) contains(offset int) bool {
+	return p.start > 0 && p.start <= offset && offset <= p.end
+}
+
+func normalizeLabel(label string) string {
+	return strings.Join(strings.Fields(strings.Trim(label, "\"")), " ")
+}
@@ -1,6 +1,13 @@
 package country
 
+// Country data is generated from iban_registry.txt, the text release of the
+// SWIFT IBAN registry, merged with overlay.json which holds entry types of
+// bban parts, alpha-3 codes and national check digit validators.
+//go:generate go run ../cmd/ibangen -registry iban_registry.txt -release 98 -overlay overlay.json -o data.go -examples ../iban/examples_test.go
+
 import (
+	"time"
+
 	"github.com/jbub/banking/bban"
 )
 
@@ -10,6 +17,26 @@ type Country struct {
 	Alpha2Code string
 	Alpha3Code string
 	Structure  bban.Structure
+
+	// EffectiveFrom is the time Structure became effective, zero time
+	// means Structure is effective since the country joined the registry.
+	EffectiveFrom time.Time
+
+	// EffectiveTo is the time Structure stopped being effective, zero
+	// time means Structure is still effective.
+	EffectiveTo time.Time
+
+	// Revisions holds previous bban structures of country.
+	Revisions []Revision
+}
+
+// Revision represents bban structure used by country in a period of time.
+// Period starts at EffectiveFrom and ends before EffectiveTo, zero times
+// represent open ended period.
+type Revision struct {
+	Structure     bban.Structure
+	EffectiveFrom time.Time
+	EffectiveTo   time.Time
 }
 
 // String returns text representation of country.
@@ -17,22 +44,44 @@ func (c Country) String() string {
 	return c.Name
 }
 
-// Exists returns true if country code exists.
+// StructureAt returns bban.Structure effective at given time.
+func (c Country) StructureAt(t time.Time) (bban.Structure, bool) {
+	if effective(t, c.EffectiveFrom, c.EffectiveTo) {
+		return c.Structure, true
+	}
+	for _, r := range c.Revisions {
+		if effective(t, r.EffectiveFrom, r.EffectiveTo) {
+			return r.Structure, true
+		}
+	}
+	return bban.Structure{}, false
+}
+
+// Exists returns true if country code exists in default registry.
 func Exists(code string) bool {
-	_, ok := countries[code]
-	return ok
+	return defaultRegistry.Exists(code)
 }
 
-// Get returns country by given country code.
+// Get returns country by given country code from default registry.
 func Get(code string) (Country, bool) {
-	country, ok := countries[code]
-	return country, ok
+	return defaultRegistry.Lookup(code)
 }
 
-// GetBbanStructure returns bban.Structure by given country code.
+// GetBbanStructure returns bban.Structure by given country code from
+// default registry.
 func GetBbanStructure(code string) (bban.Structure, bool) {
-	if country, ok := Get(code); ok {
-		return country.Structure, true
+	return defaultRegistry.GetBbanStructure(code)
+}
+
+// structures returns current and previous bban structures of country.
+func (c Country) structures() []bban.Structure {
+	structures := []bban.Structure{c.Structure}
+	for _, r := range c.Revisions {
+		structures = append(structures, r.Structure)
 	}
-	return bban.Structure{}, false
+	return structures
+}
+
+func effective(t time.Time, from time.Time, to time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
 }
@@ -2,6 +2,7 @@ package country
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -43,3 +44,55 @@ func TestInvalidBbanStructure(t *testing.T) {
 	require.False(t, ok)
 	require.Equal(t, 0, struc.Length())
 }
+
+func TestStructureAt(t *testing.T) {
+	c, ok := Get("CR")
+	require.True(t, ok)
+
+	struc, ok := c.StructureAt(time.Date(2015, time.March, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	require.Equal(t, 17, struc.Length())
+
+	struc, ok = c.StructureAt(time.Date(2016, time.July, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	require.Equal(t, 18, struc.Length())
+
+	sk, ok := Get("SK")
+	require.True(t, ok)
+	struc, ok = sk.StructureAt(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	require.Equal(t, sk.Structure.Parts(), struc.Parts())
+}
+
+func TestStructureAtNotEffective(t *testing.T) {
+	c := Country{
+		Alpha2Code:    "XT",
+		Structure:     testCountry.Structure,
+		EffectiveFrom: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	_, ok := c.StructureAt(time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC))
+	require.False(t, ok)
+}
+
+func TestBbanStructureAt(t *testing.T) {
+	struc, ok := Default().GetBbanStructureAt("CR", time.Date(2015, time.March, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	require.Equal(t, 17, struc.Length())
+
+	_, ok = Default().GetBbanStructureAt("XX", time.Now())
+	require.False(t, ok)
+}
+
+func TestExperimental(t *testing.T) {
+	require.NotEmpty(t, Experimental().Countries())
+	for _, c := range Experimental().Countries() {
+		require.False(t, Exists(c.Alpha2Code), c.Alpha2Code)
+		require.True(t, isCountryCode(c.Alpha2Code), c.Alpha2Code)
+		require.Len(t, c.Alpha3Code, 3, c.Alpha2Code)
+	}
+
+	dz, ok := Experimental().Lookup("DZ")
+	require.True(t, ok)
+	require.Equal(t, "Algeria", dz.Name)
+	require.Equal(t, 22, dz.Structure.Length())
+}
@@ -0,0 +1,168 @@
+package country
+
+import "github.com/jbub/banking/bban"
+
+// experimentalRegistry holds experimental countries.
+var experimentalRegistry = NewRegistry(countryList(experimentalCountries)...)
+
+// Experimental returns registry holding countries which use iban formats
+// domestically without being part of the official IBAN registry. Their
+// formats may change without notice, experimental countries are not
+// present in the default registry.
+func Experimental() *Registry {
+	return experimentalRegistry
+}
+
+var (
+	experimentalCountries = map[string]Country{
+		"DZ": {
+			Name:       "Algeria",
+			Alpha2Code: "DZ",
+			Alpha3Code: "DZA",
+			Structure: bban.NewStructure(
+				bban.NewAccountNumber(22, bban.Num),
+			),
+		},
+		"AO": {
+			Name:       "Angola",
+			Alpha2Code: "AO",
+			Alpha3Code: "AGO",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(4, bban.Num),
+				bban.NewBranchCode(4, bban.Num),
+				bban.NewAccountNumber(11, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"BJ": {
+			Name:       "Benin",
+			Alpha2Code: "BJ",
+			Alpha3Code: "BEN",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(5, bban.AlphaNum),
+				bban.NewBranchCode(5, bban.Num),
+				bban.NewAccountNumber(12, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"BF": {
+			Name:       "Burkina Faso",
+			Alpha2Code: "BF",
+			Alpha3Code: "BFA",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(5, bban.AlphaNum),
+				bban.NewBranchCode(5, bban.Num),
+				bban.NewAccountNumber(12, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"CM": {
+			Name:       "Cameroon",
+			Alpha2Code: "CM",
+			Alpha3Code: "CMR",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(5, bban.Num),
+				bban.NewBranchCode(5, bban.Num),
+				bban.NewAccountNumber(11, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"CV": {
+			Name:       "Cape Verde",
+			Alpha2Code: "CV",
+			Alpha3Code: "CPV",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(4, bban.Num),
+				bban.NewBranchCode(4, bban.Num),
+				bban.NewAccountNumber(11, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"IR": {
+			Name:       "Iran",
+			Alpha2Code: "IR",
+			Alpha3Code: "IRN",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(3, bban.Num),
+				bban.NewAccountNumber(19, bban.Num),
+			),
+		},
+		"CI": {
+			Name:       "Ivory Coast",
+			Alpha2Code: "CI",
+			Alpha3Code: "CIV",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(5, bban.AlphaNum),
+				bban.NewBranchCode(5, bban.Num),
+				bban.NewAccountNumber(12, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"MG": {
+			Name:       "Madagascar",
+			Alpha2Code: "MG",
+			Alpha3Code: "MDG",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(5, bban.Num),
+				bban.NewBranchCode(5, bban.Num),
+				bban.NewAccountNumber(11, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"ML": {
+			Name:       "Mali",
+			Alpha2Code: "ML",
+			Alpha3Code: "MLI",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(5, bban.AlphaNum),
+				bban.NewBranchCode(5, bban.Num),
+				bban.NewAccountNumber(12, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"MZ": {
+			Name:       "Mozambique",
+			Alpha2Code: "MZ",
+			Alpha3Code: "MOZ",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(4, bban.Num),
+				bban.NewBranchCode(4, bban.Num),
+				bban.NewAccountNumber(11, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"NE": {
+			Name:       "Niger",
+			Alpha2Code: "NE",
+			Alpha3Code: "NER",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(5, bban.AlphaNum),
+				bban.NewBranchCode(5, bban.Num),
+				bban.NewAccountNumber(12, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"SN": {
+			Name:       "Senegal",
+			Alpha2Code: "SN",
+			Alpha3Code: "SEN",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(5, bban.AlphaNum),
+				bban.NewBranchCode(5, bban.Num),
+				bban.NewAccountNumber(12, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+		"TG": {
+			Name:       "Togo",
+			Alpha2Code: "TG",
+			Alpha3Code: "TGO",
+			Structure: bban.NewStructure(
+				bban.NewBankCode(5, bban.AlphaNum),
+				bban.NewBranchCode(5, bban.Num),
+				bban.NewAccountNumber(12, bban.Num),
+				bban.NewNationalCheckDigit(2, bban.Num),
+			),
+		},
+	}
+)
@@ -0,0 +1,323 @@
+package country
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/jbub/banking/bban"
+	"github.com/jbub/banking/national"
+)
+
+// Error codes returned by failures to load registry file.
+var (
+	ErrUnknownFormat    = errors.New("country: unknown registry file format")
+	ErrInvalidEntry     = errors.New("country: invalid registry file entry")
+	ErrDuplicateCountry = errors.New("country: duplicate country code")
+	ErrUnknownChecker   = errors.New("country: unknown national checker")
+)
+
+// dateLayout represents layout of effective dates in registry file.
+const dateLayout = "2006-01-02"
+
+// Format represents encoding of registry file.
+type Format int
+
+const (
+	// JSON represents registry file encoded in JSON.
+	JSON Format = iota
+
+	// YAML represents registry file encoded in YAML.
+	YAML
+)
+
+// File represents registry file holding country entries.
+type File struct {
+	Countries []FileCountry `json:"countries" yaml:"countries"`
+}
+
+// FileCountry represents country entry of registry file, previous bban
+// structures of country are listed in revisions. Checker names national
+// check digit validator of package national, e.g. CheckBelgium, which is
+// used by the structure and all revisions.
+type FileCountry struct {
+	Name          string `json:"name" yaml:"name"`
+	Alpha2Code    string `json:"alpha2" yaml:"alpha2"`
+	Alpha3Code    string `json:"alpha3" yaml:"alpha3"`
+	Checker       string `json:"checker,omitempty" yaml:"checker,omitempty"`
+	FileStructure `yaml:",inline"`
+	Revisions     []FileStructure `json:"revisions,omitempty" yaml:"revisions,omitempty"`
+}
+
+// FileStructure represents bban structure of registry file. Structure is
+// described either by parts or by registry structure notation with entry
+// types given for each element of notation. Effective dates are written
+// as YYYY-MM-DD in UTC.
+type FileStructure struct {
+	Parts         []FilePart `json:"parts,omitempty" yaml:"parts,omitempty"`
+	Bban          string     `json:"bban,omitempty" yaml:"bban,omitempty"`
+	EntryTypes    []string   `json:"entry_types,omitempty" yaml:"entry_types,omitempty"`
+	EffectiveFrom string     `json:"effective_from,omitempty" yaml:"effective_from,omitempty"`
+	EffectiveTo   string     `json:"effective_to,omitempty" yaml:"effective_to,omitempty"`
+}
+
+// FilePart represents bban part of country entry, char type and entry
+// type are text representations of bban character types and entry types.
+type FilePart struct {
+	Length    int    `json:"length" yaml:"length"`
+	CharType  string `json:"char_type" yaml:"char_type"`
+	EntryType string `json:"entry_type" yaml:"entry_type"`
+}
+
+// EntryError describes invalid entry of registry file.
+type EntryError struct {
+	Index int
+	Code  string
+	Err   error
+}
+
+// Error returns text representation of EntryError.
+func (e *EntryError) Error() string {
+	return e.Err.Error() + " (index " + strconv.Itoa(e.Index) + ", code " + strconv.Quote(e.Code) + ")"
+}
+
+// Unwrap returns underlying error.
+func (e *EntryError) Unwrap() error {
+	return e.Err
+}
+
+// Load loads registry file in given format and creates a new Registry.
+// Countries without checker shipped with the library keep their national
+// check digit validation if their bban parts are not changed by the file.
+func Load(r io.Reader, format Format) (*Registry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var f File
+	switch format {
+	case JSON:
+		err = json.Unmarshal(data, &f)
+	case YAML:
+		err = yaml.Unmarshal(data, &f)
+	default:
+		err = ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	countries, err := f.build()
+	if err != nil {
+		return nil, err
+	}
+	return NewRegistry(countries...), nil
+}
+
+// LoadFile loads registry file and creates a new Registry. Format is
+// detected by file extension, .json for JSON and .yaml or .yml for YAML.
+func LoadFile(path string) (*Registry, error) {
+	format, ok := detectFormat(path)
+	if !ok {
+		return nil, ErrUnknownFormat
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Load(f, format)
+}
+
+func (f File) build() ([]Country, error) {
+	countries := make([]Country, 0, len(f.Countries))
+	seen := make(map[string]bool, len(f.Countries))
+	for idx, entry := range f.Countries {
+		if !isCountryCode(entry.Alpha2Code) {
+			return nil, &EntryError{Index: idx, Code: entry.Alpha2Code, Err: ErrInvalidCountryCode}
+		}
+		if seen[entry.Alpha2Code] {
+			return nil, &EntryError{Index: idx, Code: entry.Alpha2Code, Err: ErrDuplicateCountry}
+		}
+		seen[entry.Alpha2Code] = true
+
+		c, err := entry.country()
+		if err != nil {
+			return nil, &EntryError{Index: idx, Code: entry.Alpha2Code, Err: err}
+		}
+		countries = append(countries, c)
+	}
+	return countries, nil
+}
+
+func (c FileCountry) country() (Country, error) {
+	var checker bban.Checker
+	if c.Checker != "" {
+		var ok bool
+		if checker, ok = national.LookupChecker(c.Checker); !ok {
+			return Country{}, ErrUnknownChecker
+		}
+	}
+
+	rev, ok := c.revision(c.FileStructure, checker)
+	if !ok {
+		return Country{}, ErrInvalidEntry
+	}
+
+	revisions := make([]Revision, 0, len(c.Revisions))
+	for _, fs := range c.Revisions {
+		r, ok := c.revision(fs, checker)
+		if !ok {
+			return Country{}, ErrInvalidEntry
+		}
+		revisions = append(revisions, r)
+	}
+	if len(revisions) == 0 {
+		revisions = nil
+	}
+
+	return Country{
+		Name:          c.Name,
+		Alpha2Code:    c.Alpha2Code,
+		Alpha3Code:    c.Alpha3Code,
+		Structure:     rev.Structure,
+		EffectiveFrom: rev.EffectiveFrom,
+		EffectiveTo:   rev.EffectiveTo,
+		Revisions:     revisions,
+	}, nil
+}
+
+// revision builds Revision from given structure using given checker,
+// without checker shipped structures with the same parts are reused to keep
+// their national check digit validation.
+func (c FileCountry) revision(fs FileStructure, checker bban.Checker) (Revision, bool) {
+	parts, ok := fs.parts()
+	if !ok {
+		return Revision{}, false
+	}
+
+	from, ok := parseDate(fs.EffectiveFrom)
+	if !ok {
+		return Revision{}, false
+	}
+	to, ok := parseDate(fs.EffectiveTo)
+	if !ok {
+		return Revision{}, false
+	}
+
+	rev := Revision{
+		Structure:     bban.NewStructure(parts...),
+		EffectiveFrom: from,
+		EffectiveTo:   to,
+	}
+	if checker != nil {
+		rev.Structure = rev.Structure.WithChecker(checker)
+	} else if shipped, ok := countries[c.Alpha2Code]; ok {
+		for _, struc := range shipped.structures() {
+			if equalParts(struc.Parts(), parts) {
+				rev.Structure = struc
+				break
+			}
+		}
+	}
+	return rev, true
+}
+
+// Structure returns bban.Structure described by FileStructure.
+func (c FileStructure) Structure() (bban.Structure, bool) {
+	parts, ok := c.parts()
+	if !ok {
+		return bban.Structure{}, false
+	}
+	return bban.NewStructure(parts...), true
+}
+
+func (c FileStructure) parts() ([]bban.Part, bool) {
+	var parts []bban.Part
+	switch {
+	case len(c.Parts) > 0 && c.Bban == "":
+		var ok bool
+		if parts, ok = c.fileParts(); !ok {
+			return nil, false
+		}
+	case len(c.Parts) == 0 && c.Bban != "":
+		entries := make([]bban.EntryType, 0, len(c.EntryTypes))
+		for _, name := range c.EntryTypes {
+			entry, ok := bban.LookupEntryType(name)
+			if !ok {
+				return nil, false
+			}
+			entries = append(entries, entry)
+		}
+		struc, err := bban.ParseStructure(c.Bban, entries...)
+		if err != nil {
+			return nil, false
+		}
+		parts = struc.Parts()
+	default:
+		return nil, false
+	}
+	return parts, true
+}
+
+func (c FileStructure) fileParts() ([]bban.Part, bool) {
+	parts := make([]bban.Part, 0, len(c.Parts))
+	for _, p := range c.Parts {
+		char, ok := bban.LookupCharType(p.CharType)
+		if !ok {
+			return nil, false
+		}
+		entry, ok := bban.LookupEntryType(p.EntryType)
+		if !ok {
+			return nil, false
+		}
+		if p.Length <= 0 {
+			return nil, false
+		}
+		parts = append(parts, bban.NewPart(p.Length, char, entry))
+	}
+	return parts, true
+}
+
+func equalParts(a []bban.Part, b []bban.Part) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDate parses effective date, empty date is zero time.
+func parseDate(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, true
+	}
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func detectFormat(path string) (Format, bool) {
+	switch filepath.Ext(path) {
+	case ".json":
+		return JSON, true
+	case ".yaml", ".yml":
+		return YAML, true
+	}
+	return 0, false
+}
@@ -0,0 +1,152 @@
+package country
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/bban"
+)
+
+var (
+	invalidFileCases = []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"country code", `{"countries": [{"alpha2": "X", "parts": [{"length": 4, "char_type": "Num", "entry_type": "BankCode"}]}]}`, ErrInvalidCountryCode},
+		{"no parts", `{"countries": [{"alpha2": "XT", "parts": []}]}`, ErrInvalidEntry},
+		{"char type", `{"countries": [{"alpha2": "XT", "parts": [{"length": 4, "char_type": "Alpha", "entry_type": "BankCode"}]}]}`, ErrInvalidEntry},
+		{"entry type", `{"countries": [{"alpha2": "XT", "parts": [{"length": 4, "char_type": "Num", "entry_type": "Bank"}]}]}`, ErrInvalidEntry},
+		{"length", `{"countries": [{"alpha2": "XT", "parts": [{"length": 0, "char_type": "Num", "entry_type": "BankCode"}]}]}`, ErrInvalidEntry},
+		{"notation", `{"countries": [{"alpha2": "XT", "bban": "4!x"}]}`, ErrInvalidEntry},
+		{"notation entry type", `{"countries": [{"alpha2": "XT", "bban": "4!n", "entry_types": ["Bank"]}]}`, ErrInvalidEntry},
+		{"notation entry types", `{"countries": [{"alpha2": "XT", "bban": "4!n6!n", "entry_types": ["BankCode"]}]}`, ErrInvalidEntry},
+		{"notation and parts", `{"countries": [{"alpha2": "XT", "bban": "4!n", "parts": [{"length": 4, "char_type": "Num", "entry_type": "BankCode"}]}]}`, ErrInvalidEntry},
+		{"effective date", `{"countries": [{"alpha2": "XT", "bban": "4!n", "entry_types": ["BankCode"], "effective_from": "2016/07/01"}]}`, ErrInvalidEntry},
+		{"revision", `{"countries": [{"alpha2": "XT", "bban": "4!n", "entry_types": ["BankCode"], "revisions": [{"bban": "4!x"}]}]}`, ErrInvalidEntry},
+		{"checker", `{"countries": [{"alpha2": "XT", "bban": "4!n", "entry_types": ["BankCode"], "checker": "CheckMars"}]}`, ErrUnknownChecker},
+		{"duplicate", `{"countries": [
+			{"alpha2": "XT", "parts": [{"length": 4, "char_type": "Num", "entry_type": "BankCode"}]},
+			{"alpha2": "XT", "parts": [{"length": 4, "char_type": "Num", "entry_type": "BankCode"}]}
+		]}`, ErrDuplicateCountry},
+	}
+)
+
+func TestLoadFileJSON(t *testing.T) {
+	reg, err := LoadFile("testdata/registry.json")
+	require.NoError(t, err)
+	require.Len(t, reg.Countries(), 2)
+
+	be, ok := reg.Lookup("BE")
+	require.True(t, ok)
+	require.Equal(t, "Belgium", be.Name)
+	require.Equal(t, "BEL", be.Alpha3Code)
+	require.Equal(t, 12, be.Structure.Length())
+	require.True(t, be.Structure.HasChecker())
+
+	xt, ok := reg.Lookup("XT")
+	require.True(t, ok)
+	require.Equal(t, []bban.Part{
+		bban.NewBankCode(4, bban.AlphaUpper),
+		bban.NewAccountNumber(10, bban.Num),
+	}, xt.Structure.Parts())
+	require.False(t, xt.Structure.HasChecker())
+}
+
+func TestLoadFileYAML(t *testing.T) {
+	reg, err := LoadFile("testdata/registry.yaml")
+	require.NoError(t, err)
+	require.Len(t, reg.Countries(), 2)
+
+	be, ok := reg.Lookup("BE")
+	require.True(t, ok)
+	require.Equal(t, 12, be.Structure.Length())
+	require.False(t, be.Structure.HasChecker())
+
+	xt, ok := reg.Lookup("XT")
+	require.True(t, ok)
+	require.Equal(t, "4!a10!n", xt.Structure.Notation())
+	require.Equal(t, bban.BankCode, xt.Structure.Parts()[0].EntryType)
+}
+
+func TestLoadRevisions(t *testing.T) {
+	data := `{"countries": [{
+		"alpha2": "XT",
+		"bban": "4!n10!n",
+		"entry_types": ["BankCode", "AccountNumber"],
+		"effective_from": "2020-01-01",
+		"revisions": [{"bban": "4!n8!n", "entry_types": ["BankCode", "AccountNumber"], "effective_to": "2020-01-01"}]
+	}]}`
+	reg, err := Load(strings.NewReader(data), JSON)
+	require.NoError(t, err)
+
+	xt, ok := reg.Lookup("XT")
+	require.True(t, ok)
+	require.Equal(t, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), xt.EffectiveFrom)
+	require.Len(t, xt.Revisions, 1)
+	require.Equal(t, "4!n8!n", xt.Revisions[0].Structure.Notation())
+
+	struc, ok := reg.GetBbanStructureAt("XT", time.Date(2019, time.June, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	require.Equal(t, 12, struc.Length())
+}
+
+func TestLoadChecker(t *testing.T) {
+	data := `{"countries": [{
+		"alpha2": "XT",
+		"bban": "4!n11!n",
+		"entry_types": ["BankCode", "AccountNumber"],
+		"checker": "CheckMod9710",
+		"revisions": [{"bban": "4!n9!n", "entry_types": ["BankCode", "AccountNumber"], "effective_to": "2020-01-01"}]
+	}]}`
+	reg, err := Load(strings.NewReader(data), JSON)
+	require.NoError(t, err)
+
+	xt, ok := reg.Lookup("XT")
+	require.True(t, ok)
+	require.True(t, xt.Structure.HasChecker())
+	require.True(t, xt.Revisions[0].Structure.HasChecker())
+	require.True(t, xt.Structure.Check("250120000058984"))
+	require.False(t, xt.Structure.Check("250120000058948"))
+}
+
+func TestLoadFileInvalid(t *testing.T) {
+	_, err := LoadFile("testdata/registry.txt")
+	require.Equal(t, ErrUnknownFormat, err)
+
+	_, err = LoadFile("testdata/missing.json")
+	require.Error(t, err)
+
+	_, err = Load(strings.NewReader("{}"), Format(-1))
+	require.Equal(t, ErrUnknownFormat, err)
+
+	_, err = Load(strings.NewReader("{"), JSON)
+	require.Error(t, err)
+}
+
+func TestLoadInvalidEntry(t *testing.T) {
+	for _, cs := range invalidFileCases {
+		t.Run(cs.name, func(t *testing.T) {
+			_, err := Load(strings.NewReader(cs.data), JSON)
+			require.ErrorIs(t, err, cs.err)
+
+			var entryErr *EntryError
+			require.True(t, errors.As(err, &entryErr))
+			require.Contains(t, err.Error(), cs.err.Error())
+		})
+	}
+}
+
+func TestRegistryReplace(t *testing.T) {
+	reg := NewRegistry(testCountry)
+	sk, _ := Get("SK")
+
+	reg.Replace(sk)
+	require.False(t, reg.Exists("XT"))
+	require.True(t, reg.Exists("SK"))
+	require.Len(t, reg.Countries(), 1)
+}
@@ -0,0 +1,141 @@
+package country
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jbub/banking/bban"
+)
+
+// Error codes returned by failures to register a country.
+var (
+	ErrInvalidCountryCode = errors.New("country: invalid country code")
+)
+
+// defaultRegistry holds countries used by package level functions.
+var defaultRegistry = NewRegistry(countryList(countries)...)
+
+// Registry holds countries keyed by alpha-2 country code. Registry is
+// safe for concurrent use, zero value is an empty registry.
+type Registry struct {
+	mu        sync.RWMutex
+	countries map[string]Country
+}
+
+// NewRegistry creates a new Registry holding given countries.
+func NewRegistry(countries ...Country) *Registry {
+	reg := &Registry{countries: make(map[string]Country, len(countries))}
+	for _, c := range countries {
+		reg.countries[c.Alpha2Code] = c
+	}
+	return reg
+}
+
+// Default returns registry holding countries shipped with the library,
+// it is used by package level functions.
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// Register adds country to registry, country registered with the same
+// alpha-2 code is replaced.
+func (r *Registry) Register(c Country) error {
+	if !isCountryCode(c.Alpha2Code) {
+		return ErrInvalidCountryCode
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.countries == nil {
+		r.countries = make(map[string]Country)
+	}
+	r.countries[c.Alpha2Code] = c
+	return nil
+}
+
+// Lookup returns country by given country code.
+func (r *Registry) Lookup(code string) (Country, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.countries[code]
+	return c, ok
+}
+
+// Remove removes country with given country code from registry.
+func (r *Registry) Remove(code string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.countries, code)
+}
+
+// Countries returns all registered countries sorted by alpha-2 code.
+func (r *Registry) Countries() []Country {
+	r.mu.RLock()
+	countries := make([]Country, 0, len(r.countries))
+	for _, c := range r.countries {
+		countries = append(countries, c)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(countries, func(i, j int) bool {
+		return countries[i].Alpha2Code < countries[j].Alpha2Code
+	})
+	return countries
+}
+
+// Replace atomically replaces all countries of registry with given
+// countries, concurrent lookups see either previous or new countries.
+func (r *Registry) Replace(countries ...Country) {
+	r.replace(NewRegistry(countries...))
+}
+
+// Exists returns true if country code exists.
+func (r *Registry) Exists(code string) bool {
+	_, ok := r.Lookup(code)
+	return ok
+}
+
+// GetBbanStructure returns bban.Structure by given country code.
+func (r *Registry) GetBbanStructure(code string) (bban.Structure, bool) {
+	if c, ok := r.Lookup(code); ok {
+		return c.Structure, true
+	}
+	return bban.Structure{}, false
+}
+
+// GetBbanStructureAt returns bban.Structure of country with given country
+// code effective at given time.
+func (r *Registry) GetBbanStructureAt(code string, t time.Time) (bban.Structure, bool) {
+	if c, ok := r.Lookup(code); ok {
+		return c.StructureAt(t)
+	}
+	return bban.Structure{}, false
+}
+
+func (r *Registry) replace(other *Registry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.countries = other.countries
+}
+
+func countryList(m map[string]Country) []Country {
+	list := make([]Country, 0, len(m))
+	for _, c := range m {
+		list = append(list, c)
+	}
+	return list
+}
+
+func isCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
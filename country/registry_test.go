@@ -0,0 +1,113 @@
+package country
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/bban"
+)
+
+var (
+	testCountry = Country{
+		Name:       "Test",
+		Alpha2Code: "XT",
+		Alpha3Code: "XTT",
+		Structure: bban.NewStructure(
+			bban.NewBankCode(4, bban.AlphaUpper),
+			bban.NewAccountNumber(10, bban.Num),
+		),
+	}
+)
+
+func TestDefaultRegistry(t *testing.T) {
+	reg := Default()
+	require.Len(t, reg.Countries(), len(countries))
+
+	c, ok := reg.Lookup("SK")
+	require.True(t, ok)
+	require.Equal(t, "Slovakia", c.Name)
+}
+
+func TestRegistryRegister(t *testing.T) {
+	reg := NewRegistry()
+	require.False(t, reg.Exists("XT"))
+
+	require.NoError(t, reg.Register(testCountry))
+	c, ok := reg.Lookup("XT")
+	require.True(t, ok)
+	require.Equal(t, testCountry.Name, c.Name)
+
+	struc, ok := reg.GetBbanStructure("XT")
+	require.True(t, ok)
+	require.Equal(t, 14, struc.Length())
+
+	override := testCountry
+	override.Name = "Override"
+	require.NoError(t, reg.Register(override))
+	c, _ = reg.Lookup("XT")
+	require.Equal(t, "Override", c.Name)
+	require.Len(t, reg.Countries(), 1)
+}
+
+func TestRegistryZeroValue(t *testing.T) {
+	var reg Registry
+	require.False(t, reg.Exists("XT"))
+	require.NoError(t, reg.Register(testCountry))
+	require.True(t, reg.Exists("XT"))
+}
+
+func TestRegistryRegisterInvalid(t *testing.T) {
+	reg := NewRegistry()
+	for _, code := range []string{"", "X", "XTT", "xt", "X1"} {
+		c := testCountry
+		c.Alpha2Code = code
+		require.Equal(t, ErrInvalidCountryCode, reg.Register(c), code)
+	}
+	require.Empty(t, reg.Countries())
+}
+
+func TestRegistryRemove(t *testing.T) {
+	reg := NewRegistry(testCountry)
+	require.True(t, reg.Exists("XT"))
+
+	reg.Remove("XT")
+	require.False(t, reg.Exists("XT"))
+
+	struc, ok := reg.GetBbanStructure("XT")
+	require.False(t, ok)
+	require.Equal(t, 0, struc.Length())
+}
+
+func TestRegistryCountries(t *testing.T) {
+	sk, _ := Get("SK")
+	at, _ := Get("AT")
+	reg := NewRegistry(sk, testCountry, at)
+
+	var codes []string
+	for _, c := range reg.Countries() {
+		codes = append(codes, c.Alpha2Code)
+	}
+	require.Equal(t, []string{"AT", "SK", "XT"}, codes)
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	reg := NewRegistry()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = reg.Register(testCountry)
+			reg.Remove(testCountry.Alpha2Code)
+		}()
+		go func() {
+			defer wg.Done()
+			reg.Lookup(testCountry.Alpha2Code)
+			reg.Countries()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,111 @@
+package country
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"os"
+	"sync"
+	"time"
+)
+
+// Watcher reloads registry file into a Registry when the file changes.
+// Changed file is loaded only after it keeps the same content for two
+// consecutive polls, so that a file which is still being written is not
+// loaded. Writers which may pause for longer than the poll interval should
+// write a temporary file and rename it over the registry file.
+type Watcher struct {
+	reg      *Registry
+	path     string
+	interval time.Duration
+
+	mu      sync.Mutex
+	hash    [sha256.Size]byte
+	pending [sha256.Size]byte
+	changed bool
+}
+
+// NewWatcher creates a new Watcher polling registry file at given path
+// with given interval and loading it into given registry.
+func NewWatcher(reg *Registry, path string, interval time.Duration) *Watcher {
+	return &Watcher{
+		reg:      reg,
+		path:     path,
+		interval: interval,
+	}
+}
+
+// Reload loads registry file immediately and atomically replaces all
+// countries of registry, registry is left unchanged on failure. File must
+// not be written while it is reloaded.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+	return w.reload(data)
+}
+
+// Run polls registry file until ctx is done and reloads it when its
+// content changes and stays the same for two polls. Reload errors are
+// passed to onError which can be nil, registry keeps its countries until
+// the file is fixed.
+func (w *Watcher) Run(ctx context.Context, onError func(error)) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.poll(); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+func (w *Watcher) poll() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(data)
+	switch {
+	case hash == w.hash:
+		w.changed = false
+		return nil
+	case !w.changed || hash != w.pending:
+		// content is loaded on next poll if it does not change
+		w.pending = hash
+		w.changed = true
+		return nil
+	}
+	w.changed = false
+	return w.reload(data)
+}
+
+func (w *Watcher) reload(data []byte) error {
+	// file is marked as seen even if it fails to load so that the
+	// same failure is not reported on each poll
+	w.hash = sha256.Sum256(data)
+
+	format, ok := detectFormat(w.path)
+	if !ok {
+		return ErrUnknownFormat
+	}
+	loaded, err := Load(bytes.NewReader(data), format)
+	if err != nil {
+		return err
+	}
+	w.reg.replace(loaded)
+	return nil
+}
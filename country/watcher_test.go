@@ -0,0 +1,152 @@
+package country
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	pilotFile = `{"countries": [{"name": "Pilot", "alpha2": "XT", "alpha3": "XTT", "parts": [
+		{"length": 4, "char_type": "AlphaUpper", "entry_type": "BankCode"},
+		{"length": 10, "char_type": "Num", "entry_type": "AccountNumber"}
+	]}]}`
+
+	updatedPilotFile = `{"countries": [{"name": "Pilot", "alpha2": "XT", "alpha3": "XTT", "parts": [
+		{"length": 4, "char_type": "AlphaUpper", "entry_type": "BankCode"},
+		{"length": 12, "char_type": "Num", "entry_type": "AccountNumber"}
+	]}]}`
+)
+
+func TestWatcherReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "registry.json")
+	require.NoError(t, os.WriteFile(path, []byte(pilotFile), 0o600))
+
+	reg := NewRegistry(Default().Countries()...)
+	w := NewWatcher(reg, path, time.Millisecond)
+	require.NoError(t, w.Reload())
+
+	require.False(t, reg.Exists("SK"))
+	struc, ok := reg.GetBbanStructure("XT")
+	require.True(t, ok)
+	require.Equal(t, 14, struc.Length())
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	require.Error(t, w.Reload())
+	require.True(t, reg.Exists("XT"))
+}
+
+func TestWatcherPoll(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "registry.json")
+	require.NoError(t, os.WriteFile(path, []byte(pilotFile), 0o600))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	reg := NewRegistry()
+	w := NewWatcher(reg, path, time.Millisecond)
+	require.NoError(t, w.poll())
+	require.False(t, reg.Exists("XT"))
+	require.NoError(t, w.poll())
+	requireStructureLength(t, reg, 14)
+
+	// same size edit keeping modification time is still detected
+	require.NoError(t, os.WriteFile(path, []byte(updatedPilotFile), 0o600))
+	require.NoError(t, os.Chtimes(path, info.ModTime(), info.ModTime()))
+	require.NoError(t, w.poll())
+	requireStructureLength(t, reg, 14)
+	require.NoError(t, w.poll())
+	requireStructureLength(t, reg, 16)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	require.NoError(t, w.poll())
+	require.Error(t, w.poll())
+	require.NoError(t, w.poll())
+	requireStructureLength(t, reg, 16)
+}
+
+func TestWatcherPollTruncated(t *testing.T) {
+	data, err := os.ReadFile("testdata/registry.yaml")
+	require.NoError(t, err)
+	idx := bytes.Index(data, []byte("  - name: Pilot"))
+	require.Positive(t, idx)
+
+	// truncated file is valid, it holds only the first country
+	_, err = Load(bytes.NewReader(data[:idx]), YAML)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "registry.yaml")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	reg := NewRegistry()
+	w := NewWatcher(reg, path, time.Millisecond)
+	require.NoError(t, w.Reload())
+	require.Len(t, reg.Countries(), 2)
+
+	// file is being rewritten, the first half is seen by a single poll
+	require.NoError(t, os.WriteFile(path, data[:idx], 0o600))
+	require.NoError(t, w.poll())
+	require.NoError(t, os.WriteFile(path, bytes.Replace(data, []byte("10!n"), []byte("12!n"), 1), 0o600))
+	require.NoError(t, w.poll())
+	require.Len(t, reg.Countries(), 2)
+	requireStructureLength(t, reg, 14)
+
+	require.NoError(t, w.poll())
+	require.Len(t, reg.Countries(), 2)
+	requireStructureLength(t, reg, 16)
+}
+
+func TestWatcherRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "registry.json")
+	require.NoError(t, os.WriteFile(path, []byte(pilotFile), 0o600))
+
+	reg := NewRegistry()
+	w := NewWatcher(reg, path, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 10)
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx, func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		})
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	requireLength(t, reg, 14)
+
+	require.NoError(t, os.WriteFile(path, []byte(updatedPilotFile), 0o600))
+	requireLength(t, reg, 16)
+
+	require.NoError(t, os.WriteFile(path, []byte("{}{"), 0o600))
+	select {
+	case err := <-errs:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("reload error not reported")
+	}
+	requireLength(t, reg, 16)
+}
+
+func requireStructureLength(t *testing.T, reg *Registry, length int) {
+	struc, ok := reg.GetBbanStructure("XT")
+	require.True(t, ok)
+	require.Equal(t, length, struc.Length())
+}
+
+func requireLength(t *testing.T, reg *Registry, length int) {
+	require.Eventually(t, func() bool {
+		struc, ok := reg.GetBbanStructure("XT")
+		return ok && struc.Length() == length
+	}, 5*time.Second, time.Millisecond)
+}
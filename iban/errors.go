@@ -0,0 +1,103 @@
+package iban
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jbub/banking/bban"
+)
+
+// Code represents a stable machine readable code of validation error.
+type Code string
+
+// Codes of validation errors.
+const (
+	CodeIbanTooShort              Code = "IBAN_TOO_SHORT"
+	CodeCountryCodeNotUpper       Code = "IBAN_COUNTRY_CODE_NOT_UPPER"
+	CodeCountryCodeNotAlpha       Code = "IBAN_COUNTRY_CODE_NOT_ALPHA"
+	CodeCountryCodeNotPresent     Code = "IBAN_COUNTRY_CODE_NOT_PRESENT"
+	CodeInvalidCheckDigit         Code = "IBAN_CHECK_DIGIT_INVALID"
+	CodeInvalidIbanModulo         Code = "IBAN_MODULO_INVALID"
+	CodeInvalidBbanLength         Code = "IBAN_BBAN_LENGTH_INVALID"
+	CodeInvalidBbanPart           Code = "IBAN_BBAN_PART_INVALID"
+	CodeMissingBbanPart           Code = "IBAN_BBAN_PART_MISSING"
+	CodeUnexpectedBbanPart        Code = "IBAN_BBAN_PART_UNEXPECTED"
+	CodeInvalidNationalCheckDigit Code = "IBAN_NATIONAL_CHECK_DIGIT_INVALID"
+)
+
+// ValidationError describes a failure to validate an iban. It wraps one of
+// the Err* sentinel errors, so errors.Is can be used to check for them.
+type ValidationError struct {
+	// Code is a machine readable code of the error.
+	Code Code
+
+	// Offset is a byte offset of the failing character or part in iban.
+	Offset int
+
+	// Part is the failing bban part, nil if error is not related to a part.
+	Part *bban.Part
+
+	// Length is the expected length of iban, bban or bban part.
+	Length int
+
+	// CharType is the expected character type of the failing bban part.
+	CharType string
+
+	// CheckDigit is the expected check digit of iban.
+	CheckDigit string
+
+	// Err is the underlying sentinel error.
+	Err error
+}
+
+// Error returns text representation of ValidationError.
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(e.Err.Error())
+	if e.Part != nil {
+		sb.WriteString(": ")
+		sb.WriteString(e.Part.String())
+	}
+	sb.WriteString(" at offset ")
+	sb.WriteString(strconv.Itoa(e.Offset))
+
+	switch {
+	case e.CheckDigit != "":
+		sb.WriteString(", expected ")
+		sb.WriteString(e.CheckDigit)
+	case e.CharType != "":
+		sb.WriteString(", expected ")
+		sb.WriteString(strconv.Itoa(e.Length))
+		sb.WriteString(" ")
+		sb.WriteString(e.CharType)
+		sb.WriteString(" characters")
+	case e.Length > 0:
+		sb.WriteString(", expected length ")
+		sb.WriteString(strconv.Itoa(e.Length))
+	}
+	return sb.String()
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+func newError(code Code, offset int, err error) *ValidationError {
+	return &ValidationError{
+		Code:   code,
+		Offset: offset,
+		Err:    err,
+	}
+}
+
+func newPartError(code Code, offset int, part bban.Part, err error) *ValidationError {
+	return &ValidationError{
+		Code:     code,
+		Offset:   offset,
+		Part:     &part,
+		Length:   part.Length,
+		CharType: part.CharTypeName(),
+		Err:      err,
+	}
+}
@@ -0,0 +1,138 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/bban"
+)
+
+var (
+	validationErrorCases = []struct {
+		iban       string
+		code       Code
+		offset     int
+		entryType  bban.EntryType
+		length     int
+		charType   string
+		checkDigit string
+		err        error
+		msg        string
+	}{
+		{
+			iban:   "AL4721211",
+			code:   CodeIbanTooShort,
+			offset: 9,
+			length: 15,
+			err:    ErrIbanTooShort,
+			msg:    "iban: iban too short at offset 9, expected length 15",
+		},
+		{
+			iban:   "Sl472121142342323",
+			code:   CodeCountryCodeNotUpper,
+			offset: 1,
+			err:    ErrCountryCodeNotUpper,
+			msg:    "iban: country code contains lowercase letters at offset 1",
+		},
+		{
+			iban:   "S4472121142342323",
+			code:   CodeCountryCodeNotAlpha,
+			offset: 1,
+			err:    ErrCountryCodeNotAlpha,
+			msg:    "iban: country code contains non alphabetic letters at offset 1",
+		},
+		{
+			iban:   "XX472121142342323",
+			code:   CodeCountryCodeNotPresent,
+			offset: 0,
+			err:    ErrCountryCodeNotPresent,
+			msg:    "iban: country code does not exist at offset 0",
+		},
+		{
+			iban:   "AT61190430023457320",
+			code:   CodeInvalidBbanLength,
+			offset: 4,
+			length: 16,
+			err:    ErrInvalidBbanLength,
+			msg:    "iban: invalid bban length at offset 4, expected length 16",
+		},
+		{
+			iban:      "SK061100A000002920884960",
+			code:      CodeInvalidBbanPart,
+			offset:    8,
+			entryType: bban.AccountNumberPrefix,
+			length:    6,
+			charType:  "Num",
+			err:       ErrInvalidBbanPart,
+			msg:       "iban: invalid bban part: AccountNumberPrefix at offset 8, expected 6 Num characters",
+		},
+		{
+			iban:       "PL67102010260000042270201111",
+			code:       CodeInvalidCheckDigit,
+			offset:     2,
+			length:     2,
+			checkDigit: "60",
+			err:        ErrInvalidCheckDigit,
+			msg:        "iban: invalid check digit at offset 2, expected 60",
+		},
+	}
+)
+
+func TestValidationError(t *testing.T) {
+	for _, cs := range validationErrorCases {
+		t.Run(cs.iban, func(t *testing.T) {
+			err := Validate(cs.iban)
+			require.ErrorIs(t, err, cs.err)
+			require.EqualError(t, err, cs.msg)
+
+			var verr *ValidationError
+			require.True(t, errors.As(err, &verr))
+			require.Equal(t, cs.code, verr.Code)
+			require.Equal(t, cs.offset, verr.Offset)
+			require.Equal(t, cs.length, verr.Length)
+			require.Equal(t, cs.charType, verr.CharType)
+			require.Equal(t, cs.checkDigit, verr.CheckDigit)
+			if cs.charType != "" {
+				require.NotNil(t, verr.Part)
+				require.Equal(t, cs.entryType, verr.Part.EntryType)
+			} else {
+				require.Nil(t, verr.Part)
+			}
+		})
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	for _, cs := range validCases {
+		t.Run(cs.iban, func(t *testing.T) {
+			require.Empty(t, ValidateAll(cs.iban))
+		})
+	}
+}
+
+func TestValidateAllInvalid(t *testing.T) {
+	cases := []struct {
+		iban  string
+		codes []Code
+	}{
+		{"AL4721211", []Code{CodeIbanTooShort}},
+		{"XX472121142342323", []Code{CodeCountryCodeNotPresent}},
+		{"GB29NWBK6016133192681", []Code{CodeInvalidBbanLength, CodeInvalidCheckDigit}},
+		{"GB00NW3K6016X331926819", []Code{CodeInvalidBbanPart, CodeInvalidBbanPart, CodeInvalidCheckDigit}},
+		{"GB00NWBK6016133192681-", []Code{CodeInvalidBbanPart, CodeInvalidIbanModulo}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.iban, func(t *testing.T) {
+			errs := ValidateAll(cs.iban)
+			require.Len(t, errs, len(cs.codes))
+			for idx, err := range errs {
+				var verr *ValidationError
+				require.True(t, errors.As(err, &verr))
+				require.Equal(t, cs.codes[idx], verr.Code)
+			}
+			require.Equal(t, errs[0], Validate(cs.iban))
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package iban
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jbub/banking/bban"
+)
+
+const (
+	// printGroupSize represents size of character groups in print format.
+	printGroupSize = 4
+
+	// maskVisible represents number of trailing characters left visible in masked iban.
+	maskVisible = 4
+
+	// maskChar is used to mask iban characters.
+	maskChar = '*'
+)
+
+// PrintString returns print (paper) format of iban as specified in ISO 13616,
+// characters are split into groups of four separated by single space.
+func (i *Iban) PrintString() string {
+	return printFormat(i.value)
+}
+
+// MaskedString returns iban with all bban characters except
+// the last four replaced by asterisks.
+func (i *Iban) MaskedString() string {
+	return maskFormat(i.value)
+}
+
+// Format implements fmt.Formatter.
+//
+// Verbs %s and %v print electronic format, the space flag (% s) switches
+// to print format and the sharp flag (%#s) masks the bban. Verb %+v lists
+// parsed parts of iban and %q prints quoted electronic format.
+func (i *Iban) Format(f fmt.State, verb rune) {
+	var s string
+	switch verb {
+	case 's', 'v':
+		switch {
+		case verb == 'v' && f.Flag('+'):
+			s = i.partsString()
+		case f.Flag('#'):
+			s = maskFormat(i.value)
+			if f.Flag(' ') {
+				s = printFormat(s)
+			}
+		case f.Flag(' '):
+			s = printFormat(i.value)
+		default:
+			s = i.value
+		}
+	case 'q':
+		s = strconv.Quote(i.value)
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(iban.Iban=%s)", verb, i.value)
+		return
+	}
+	writePadded(f, s)
+}
+
+func (i *Iban) partsString() string {
+	var sb strings.Builder
+	sb.WriteString(i.value)
+	sb.WriteString(" (CountryCode: ")
+	sb.WriteString(i.CountryCode())
+	sb.WriteString(", CheckDigit: ")
+	sb.WriteString(i.CheckDigit())
+
+	bbn := i.Bban()
+	var offset int
+	for _, part := range i.struc.Parts() {
+		value := bbn[offset : offset+part.Length]
+		offset += part.Length
+		if part.EntryType == bban.Padding {
+			continue
+		}
+		sb.WriteString(", ")
+		sb.WriteString(part.String())
+		sb.WriteString(": ")
+		sb.WriteString(value)
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+func writePadded(f fmt.State, s string) {
+	width, ok := f.Width()
+	if !ok || width <= len(s) {
+		_, _ = f.Write([]byte(s))
+		return
+	}
+
+	pad := strings.Repeat(" ", width-len(s))
+	if f.Flag('-') {
+		_, _ = f.Write([]byte(s + pad))
+		return
+	}
+	_, _ = f.Write([]byte(pad + s))
+}
+
+func printFormat(value string) string {
+	var sb strings.Builder
+	sb.Grow(len(value) + len(value)/printGroupSize)
+	for idx, r := range value {
+		if idx > 0 && idx%printGroupSize == 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func maskFormat(value string) string {
+	prefix := len(value) - len(extractBban(value))
+	visible := len(value) - maskVisible
+	if visible < prefix {
+		visible = prefix
+	}
+
+	b := []byte(value)
+	for idx := prefix; idx < visible; idx++ {
+		b[idx] = maskChar
+	}
+	return string(b)
+}
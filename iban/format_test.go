@@ -0,0 +1,66 @@
+package iban
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	formatCases = []struct {
+		iban   string
+		format string
+		want   string
+	}{
+		{"BE68539007547034", "%s", "BE68539007547034"},
+		{"BE68539007547034", "%v", "BE68539007547034"},
+		{"BE68539007547034", "% s", "BE68 5390 0754 7034"},
+		{"BE68539007547034", "% v", "BE68 5390 0754 7034"},
+		{"BE68539007547034", "%#s", "BE68********7034"},
+		{"BE68539007547034", "% #s", "BE68 **** **** 7034"},
+		{"BE68539007547034", "%q", `"BE68539007547034"`},
+		{"BE68539007547034", "%20s", "    BE68539007547034"},
+		{"BE68539007547034", "%-20s|", "BE68539007547034    |"},
+		{"BE68539007547034", "%d", "%!d(iban.Iban=BE68539007547034)"},
+		{
+			"BE68539007547034",
+			"%+v",
+			"BE68539007547034 (CountryCode: BE, CheckDigit: 68, BankCode: 539, AccountNumber: 0075470, NationalCheckDigit: 34)",
+		},
+		{
+			"MU17BOMM0101101030300200000MUR",
+			"%+v",
+			"MU17BOMM0101101030300200000MUR (CountryCode: MU, CheckDigit: 17, BankCode: BOMM01, BranchCode: 01, AccountNumber: 101030300200, Currency: MUR)",
+		},
+	}
+)
+
+func TestPrintString(t *testing.T) {
+	ib := MustParse("GB29NWBK60161331926819")
+	require.Equal(t, "GB29 NWBK 6016 1331 9268 19", ib.PrintString())
+}
+
+func TestMaskedString(t *testing.T) {
+	ib := MustParse("GB29NWBK60161331926819")
+	require.Equal(t, "GB29**************6819", ib.MaskedString())
+}
+
+func TestFormat(t *testing.T) {
+	for _, cs := range formatCases {
+		t.Run(cs.format, func(t *testing.T) {
+			ib := MustParse(cs.iban)
+			require.Equal(t, cs.want, fmt.Sprintf(cs.format, ib))
+		})
+	}
+}
+
+func TestPrintStringLenient(t *testing.T) {
+	for _, cs := range validCases {
+		t.Run(cs.iban, func(t *testing.T) {
+			ib, _, err := ParseLenient(MustParse(cs.iban).PrintString())
+			require.NoError(t, err)
+			require.Equal(t, MustParse(cs.iban).ElectronicString(), ib.String())
+		})
+	}
+}
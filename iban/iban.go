@@ -2,21 +2,25 @@ package iban
 
 import (
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/jbub/banking/bban"
-	"github.com/jbub/banking/country"
 )
 
 // Error codes returned by failures to validate an iban.
 var (
-	ErrIbanTooShort          = errors.New("iban: iban too short")
-	ErrCountryCodeNotUpper   = errors.New("iban: country code contains lowercase letters")
-	ErrCountryCodeNotAlpha   = errors.New("iban: country code contains non alphabetic letters")
-	ErrCountryCodeNotPresent = errors.New("iban: country code does not exist")
-	ErrInvalidCheckDigit     = errors.New("iban: invalid check digit")
-	ErrInvalidIbanModulo     = errors.New("iban: invalid modulo")
-	ErrInvalidBbanLength     = errors.New("iban: invalid bban length")
-	ErrInvalidBbanPart       = errors.New("iban: invalid bban part")
+	ErrIbanTooShort              = errors.New("iban: iban too short")
+	ErrCountryCodeNotUpper       = errors.New("iban: country code contains lowercase letters")
+	ErrCountryCodeNotAlpha       = errors.New("iban: country code contains non alphabetic letters")
+	ErrCountryCodeNotPresent     = errors.New("iban: country code does not exist")
+	ErrInvalidCheckDigit         = errors.New("iban: invalid check digit")
+	ErrInvalidIbanModulo         = errors.New("iban: invalid modulo")
+	ErrInvalidBbanLength         = errors.New("iban: invalid bban length")
+	ErrInvalidBbanPart           = errors.New("iban: invalid bban part")
+	ErrMissingBbanPart           = errors.New("iban: missing bban part")
+	ErrUnexpectedBbanPart        = errors.New("iban: unexpected bban part")
+	ErrInvalidNationalCheckDigit = errors.New("iban: invalid national check digit")
 )
 
 // Iban represents iban code. Zero value is not usable.
@@ -45,6 +49,11 @@ func (i *Iban) AccountNumber() string {
 	return extractAccountNumber(i.value, i.struc)
 }
 
+// AccountNumberPrefix returns account number prefix of iban.
+func (i *Iban) AccountNumberPrefix() string {
+	return extractAccountNumberPrefix(i.value, i.struc)
+}
+
 // BankCode returns bank code of iban.
 func (i *Iban) BankCode() string {
 	return extractBankCode(i.value, i.struc)
@@ -80,66 +89,70 @@ func (i *Iban) Currency() string {
 	return extractCurrency(i.value, i.struc)
 }
 
-// String returns text representation of iban.
+// String returns text representation of iban as it was parsed, letters of
+// alphanumeric bban parts keep their case.
 func (i *Iban) String() string {
 	return i.value
 }
 
-// Validate validates iban code.
-func Validate(value string) error {
-	_, err := validate(value)
-	return err
+// ElectronicString returns electronic format of iban with all letters
+// uppercased, it is the same for ibans differing only in case.
+func (i *Iban) ElectronicString() string {
+	return strings.ToUpper(i.value)
+}
+
+// Equal returns true if both ibans have the same electronic format.
+func (i *Iban) Equal(other *Iban) bool {
+	return i.ElectronicString() == other.ElectronicString()
+}
+
+// Validate validates iban code using default country registry.
+func Validate(value string, opts ...Option) error {
+	return defaultParserWith(opts).Validate(value)
+}
+
+// ValidateAt validates iban code using default country registry against
+// bban structure of its country effective at given time.
+func ValidateAt(value string, t time.Time, opts ...Option) error {
+	return defaultParserWith(opts).ValidateAt(value, t)
+}
+
+// ValidateAll validates iban code using default country registry and
+// returns all found validation errors, nil is returned for valid iban code.
+func ValidateAll(value string, opts ...Option) []error {
+	return defaultParserWith(opts).ValidateAll(value)
 }
 
 // New validates and creates new iban code.
 // Deprecated: Use Parse instead.
 func New(value string) (*Iban, error) {
-	struc, err := validate(value)
-	if err != nil {
-		return nil, err
-	}
-	return &Iban{
-		value: value,
-		struc: struc,
-	}, nil
+	return Parse(value)
 }
 
-// Parse validates and creates new iban code.
-func Parse(value string) (*Iban, error) {
-	return New(value)
+// Parse validates and creates new iban code using default country registry.
+func Parse(value string, opts ...Option) (*Iban, error) {
+	return defaultParserWith(opts).Parse(value)
 }
 
 // MustParse tries to create new iban code, panics on failure.
-func MustParse(value string) *Iban {
-	ibn, err := New(value)
+func MustParse(value string, opts ...Option) *Iban {
+	ibn, err := Parse(value, opts...)
 	if err != nil {
 		panic(err)
 	}
 	return ibn
 }
 
-func validate(value string) (bban.Structure, error) {
-	if err := validateMinLength(value); err != nil {
-		return bban.Structure{}, err
-	}
-
-	code := extractCountryCode(value)
-	if err := validateCountryCode(code); err != nil {
-		return bban.Structure{}, err
-	}
-
-	struc, ok := country.GetBbanStructure(code)
-	if !ok {
-		return bban.Structure{}, ErrCountryCodeNotPresent
-	}
-
-	bbn := extractBban(value)
-	if err := validateBban(bbn, struc); err != nil {
-		return struc, err
-	}
+// FromBban creates new iban code from given country code and bban using
+// default country registry, check digit is calculated.
+func FromBban(countryCode string, bbn string, opts ...Option) (*Iban, error) {
+	return defaultParserWith(opts).FromBban(countryCode, bbn)
+}
 
-	if err := validateCheckDigit(value, code); err != nil {
-		return struc, err
-	}
-	return struc, nil
+// FromParts creates new iban code from given country code and bban parts
+// keyed by their entry type using default country registry. Parts are left
+// padded with zeros to their length, missing optional parts are filled with
+// zeros. Parts not present in country bban structure are rejected.
+func FromParts(countryCode string, parts map[bban.EntryType]string, opts ...Option) (*Iban, error) {
+	return defaultParserWith(opts).FromParts(countryCode, parts)
 }
@@ -2,9 +2,11 @@ package iban
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/jbub/banking/bban"
 	"github.com/jbub/banking/country"
 )
 
@@ -17,6 +19,7 @@ var (
 		bankCode             string
 		branchCode           string
 		accountNumber        string
+		accountNumberPrefix  string
 		identificationNumber string
 		accountType          string
 		ownerAccountType     string
@@ -42,6 +45,15 @@ var (
 			branchCode:    "3600",
 			accountNumber: "900000002Z00AB00",
 		},
+		{
+			iban:          "BY13NBRB3600900000002z00ab00",
+			countryCode:   "BY",
+			checkDigit:    "13",
+			bban:          "NBRB3600900000002z00ab00",
+			bankCode:      "NBRB",
+			branchCode:    "3600",
+			accountNumber: "900000002z00ab00",
+		},
 		{
 			iban:               "BE68539007547034",
 			countryCode:        "BE",
@@ -69,6 +81,83 @@ var (
 			accountNumber:      "000163163",
 			nationalCheckDigit: "4",
 		},
+		{
+			iban:               "ES9121000418450200051332",
+			countryCode:        "ES",
+			checkDigit:         "91",
+			bban:               "21000418450200051332",
+			bankCode:           "2100",
+			branchCode:         "0418",
+			accountNumber:      "0200051332",
+			nationalCheckDigit: "45",
+		},
+		{
+			iban:          "HR1210010051863000160",
+			countryCode:   "HR",
+			checkDigit:    "12",
+			bban:          "10010051863000160",
+			bankCode:      "1001005",
+			accountNumber: "1863000160",
+		},
+		{
+			iban:               "HU42117730161111101800000000",
+			countryCode:        "HU",
+			checkDigit:         "42",
+			bban:               "117730161111101800000000",
+			bankCode:           "117",
+			branchCode:         "7301",
+			accountNumber:      "6111110180000000",
+			nationalCheckDigit: "0",
+		},
+		{
+			iban:               "FR1420041010050500013M02606",
+			countryCode:        "FR",
+			checkDigit:         "14",
+			bban:               "20041010050500013M02606",
+			bankCode:           "20041",
+			branchCode:         "01005",
+			accountNumber:      "0500013M026",
+			nationalCheckDigit: "06",
+		},
+		{
+			iban:               "MC5811222000010123456789030",
+			countryCode:        "MC",
+			checkDigit:         "58",
+			bban:               "11222000010123456789030",
+			bankCode:           "11222",
+			branchCode:         "00001",
+			accountNumber:      "01234567890",
+			nationalCheckDigit: "30",
+		},
+		{
+			iban:               "FI2112345600000785",
+			countryCode:        "FI",
+			checkDigit:         "21",
+			bban:               "12345600000785",
+			bankCode:           "123456",
+			accountNumber:      "0000078",
+			nationalCheckDigit: "5",
+		},
+		{
+			iban:               "EE382200221020145685",
+			countryCode:        "EE",
+			checkDigit:         "38",
+			bban:               "2200221020145685",
+			bankCode:           "22",
+			branchCode:         "00",
+			accountNumber:      "22102014568",
+			nationalCheckDigit: "5",
+		},
+		{
+			iban:                 "IS140159260076545510730339",
+			countryCode:          "IS",
+			checkDigit:           "14",
+			bban:                 "0159260076545510730339",
+			bankCode:             "0159",
+			branchCode:           "26",
+			accountNumber:        "007654",
+			identificationNumber: "5510730339",
+		},
 		{
 			iban:          "GL8964710001000206",
 			countryCode:   "GL",
@@ -95,6 +184,26 @@ var (
 			branchCode:    "601613",
 			accountNumber: "31926819",
 		},
+		{
+			iban:               "IT60X0542811101000000123456",
+			countryCode:        "IT",
+			checkDigit:         "60",
+			bban:               "X0542811101000000123456",
+			bankCode:           "05428",
+			branchCode:         "11101",
+			accountNumber:      "000000123456",
+			nationalCheckDigit: "X",
+		},
+		{
+			iban:               "SM86U0322509800000000270100",
+			countryCode:        "SM",
+			checkDigit:         "86",
+			bban:               "U0322509800000000270100",
+			bankCode:           "03225",
+			branchCode:         "09800",
+			accountNumber:      "000000270100",
+			nationalCheckDigit: "U",
+		},
 		{
 			iban:          "IQ98NBIQ850123456789012",
 			countryCode:   "IQ",
@@ -149,12 +258,22 @@ var (
 			nationalCheckDigit: "6",
 		},
 		{
-			iban:          "SK0611000000002920884960",
-			countryCode:   "SK",
-			checkDigit:    "06",
-			bban:          "11000000002920884960",
-			bankCode:      "1100",
-			accountNumber: "0000002920884960",
+			iban:                "SK0611000000002920884960",
+			countryCode:         "SK",
+			checkDigit:          "06",
+			bban:                "11000000002920884960",
+			bankCode:            "1100",
+			accountNumberPrefix: "000000",
+			accountNumber:       "2920884960",
+		},
+		{
+			iban:                "CZ6508000000192000145399",
+			countryCode:         "CZ",
+			checkDigit:          "65",
+			bban:                "08000000192000145399",
+			bankCode:            "0800",
+			accountNumberPrefix: "000019",
+			accountNumber:       "2000145399",
 		},
 		{
 			iban:               "NO9386011117947",
@@ -225,6 +344,128 @@ var (
 			bankCode:      "152",
 			accountNumber: "02001026284066",
 		},
+		{
+			iban:               "BI4210000100010000332045181",
+			countryCode:        "BI",
+			checkDigit:         "42",
+			bban:               "10000100010000332045181",
+			bankCode:           "10000",
+			branchCode:         "10001",
+			accountNumber:      "00003320451",
+			nationalCheckDigit: "81",
+		},
+		{
+			iban:               "DJ2100010000000154000100186",
+			countryCode:        "DJ",
+			checkDigit:         "21",
+			bban:               "00010000000154000100186",
+			bankCode:           "00010",
+			branchCode:         "00000",
+			accountNumber:      "01540001001",
+			nationalCheckDigit: "86",
+		},
+		{
+			iban:          "SV62CENR00000000000000700025",
+			countryCode:   "SV",
+			checkDigit:    "62",
+			bban:          "CENR00000000000000700025",
+			bankCode:      "CENR",
+			accountNumber: "00000000000000700025",
+		},
+		{
+			iban:          "FK88SC123456789012",
+			countryCode:   "FK",
+			checkDigit:    "88",
+			bban:          "SC123456789012",
+			bankCode:      "SC",
+			accountNumber: "123456789012",
+		},
+		{
+			iban:          "HN88CABF00000000000250005469",
+			countryCode:   "HN",
+			checkDigit:    "88",
+			bban:          "CABF00000000000250005469",
+			bankCode:      "CABF",
+			accountNumber: "00000000000250005469",
+		},
+		{
+			iban:          "LY83002048000020100120361",
+			countryCode:   "LY",
+			checkDigit:    "83",
+			bban:          "002048000020100120361",
+			bankCode:      "002",
+			branchCode:    "048",
+			accountNumber: "000020100120361",
+		},
+		{
+			iban:          "MN121234123456789123",
+			countryCode:   "MN",
+			checkDigit:    "12",
+			bban:          "1234123456789123",
+			bankCode:      "1234",
+			accountNumber: "123456789123",
+		},
+		{
+			iban:          "NI45BAPR00000013000003558124",
+			countryCode:   "NI",
+			checkDigit:    "45",
+			bban:          "BAPR00000013000003558124",
+			bankCode:      "BAPR",
+			accountNumber: "00000013000003558124",
+		},
+		{
+			iban:          "OM810180000001299123456",
+			countryCode:   "OM",
+			checkDigit:    "81",
+			bban:          "0180000001299123456",
+			bankCode:      "018",
+			accountNumber: "0000001299123456",
+		},
+		{
+			iban:          "RU0204452560040702810412345678901",
+			countryCode:   "RU",
+			checkDigit:    "02",
+			bban:          "04452560040702810412345678901",
+			bankCode:      "044525600",
+			branchCode:    "40702",
+			accountNumber: "810412345678901",
+		},
+		{
+			iban:               "ST68000100010051845310112",
+			countryCode:        "ST",
+			checkDigit:         "68",
+			bban:               "000100010051845310112",
+			bankCode:           "0001",
+			branchCode:         "0001",
+			accountNumber:      "00518453101",
+			nationalCheckDigit: "12",
+		},
+		{
+			iban:          "SO211000001001000100141",
+			countryCode:   "SO",
+			checkDigit:    "21",
+			bban:          "1000001001000100141",
+			bankCode:      "1000",
+			branchCode:    "001",
+			accountNumber: "001000100141",
+		},
+		{
+			iban:          "SD2129010501234001",
+			countryCode:   "SD",
+			checkDigit:    "21",
+			bban:          "29010501234001",
+			bankCode:      "29",
+			accountNumber: "010501234001",
+		},
+		{
+			iban:          "YE15CBYE0001018861234567891234",
+			countryCode:   "YE",
+			checkDigit:    "15",
+			bban:          "CBYE0001018861234567891234",
+			bankCode:      "CBYE",
+			branchCode:    "0001",
+			accountNumber: "018861234567891234",
+		},
 	}
 	invalidCases = []struct {
 		iban string
@@ -270,6 +511,10 @@ var (
 			iban: "SK061100A000002920884960",
 			err:  ErrInvalidBbanPart,
 		},
+		{
+			iban: "BY13nbrb3600900000002Z00AB00",
+			err:  ErrInvalidBbanPart,
+		},
 	}
 	invalidBbanCases = []struct {
 		iban        string
@@ -348,7 +593,9 @@ func TestValidateDigit(t *testing.T) {
 	for _, cs := range validCases {
 		t.Run(cs.iban, func(t *testing.T) {
 			code := extractCountryCode(cs.iban)
-			err := validateCheckDigit(cs.iban, code)
+			struc, ok := country.GetBbanStructure(code)
+			require.True(t, ok)
+			err := validateCheckDigit(cs.iban, code, struc)
 			require.NoError(t, err)
 		})
 	}
@@ -364,6 +611,31 @@ func TestValidateCountryCode(t *testing.T) {
 	}
 }
 
+func TestValidateCountryCodeInvalid(t *testing.T) {
+	cases := []struct {
+		code   string
+		offset int
+		err    error
+	}{
+		{"1B", 0, ErrCountryCodeNotAlpha},
+		{"S4", 1, ErrCountryCodeNotAlpha},
+		{"-B", 0, ErrCountryCodeNotAlpha},
+		{"s1", 0, ErrCountryCodeNotUpper},
+		{"Sk", 1, ErrCountryCodeNotUpper},
+	}
+	for _, cs := range cases {
+		t.Run(cs.code, func(t *testing.T) {
+			err := validateCountryCode(cs.code)
+			require.ErrorIs(t, err, cs.err)
+
+			var verr *ValidationError
+			require.ErrorAs(t, err, &verr)
+			require.Equal(t, cs.offset, verr.Offset)
+		})
+	}
+	require.ErrorIs(t, Validate("1B472121142342323"), ErrCountryCodeNotAlpha)
+}
+
 func TestCalculateCheckDigit(t *testing.T) {
 	for _, cs := range validCases {
 		t.Run(cs.iban, func(t *testing.T) {
@@ -393,7 +665,7 @@ func TestValidateBbanLengthInvalid(t *testing.T) {
 			require.True(t, ok)
 			bbn := extractBban(cs.iban)
 			err := validateBban(bbn, struc)
-			require.Equal(t, ErrInvalidBbanLength, err)
+			require.ErrorIs(t, err, ErrInvalidBbanLength)
 		})
 	}
 }
@@ -418,6 +690,7 @@ func TestParse(t *testing.T) {
 			require.Equal(t, cs.bankCode, ib.BankCode())
 			require.Equal(t, cs.branchCode, ib.BranchCode())
 			require.Equal(t, cs.accountNumber, ib.AccountNumber())
+			require.Equal(t, cs.accountNumberPrefix, ib.AccountNumberPrefix())
 			require.Equal(t, cs.identificationNumber, ib.IdentificationNumber())
 			require.Equal(t, cs.accountType, ib.AccountType())
 			require.Equal(t, cs.ownerAccountType, ib.OwnerAccountType())
@@ -449,6 +722,36 @@ func TestValidateInvalid(t *testing.T) {
 	}
 }
 
+func TestElectronicString(t *testing.T) {
+	upper := MustParse("BY13NBRB3600900000002Z00AB00")
+	lower := MustParse("BY13NBRB3600900000002z00ab00")
+	require.NotEqual(t, upper.String(), lower.String())
+	require.Equal(t, upper.String(), lower.ElectronicString())
+	require.True(t, upper.Equal(lower))
+	require.False(t, upper.Equal(MustParse("GB29NWBK60161331926819")))
+}
+
+func TestValidateAt(t *testing.T) {
+	before := time.Date(2015, time.March, 1, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, ValidateAt("CR0515202001026284066", before))
+	require.ErrorIs(t, ValidateAt("CR0515202001026284066", after), ErrInvalidBbanLength)
+	require.ErrorIs(t, Validate("CR0515202001026284066"), ErrInvalidBbanLength)
+
+	require.NoError(t, ValidateAt("CR05015202001026284066", after))
+	require.ErrorIs(t, ValidateAt("CR05015202001026284066", before), ErrInvalidBbanLength)
+
+	require.NoError(t, ValidateAt("SK3112000000198742637541", before))
+	require.NoError(t, ValidateAt("DE89370400440532013000", time.Date(2005, time.January, 1, 0, 0, 0, 0, time.UTC)))
+
+	joined := time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, ValidateAt("RU0304452522540817810538091310419", joined))
+	require.ErrorIs(t, ValidateAt("RU0304452522540817810538091310419", joined.AddDate(0, 0, -1)), ErrCountryCodeNotPresent)
+	require.ErrorIs(t, ValidateAt("RU0304452522540817810538091310419", time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)), ErrCountryCodeNotPresent)
+	require.ErrorIs(t, ValidateAt("XX3112000000198742637541", after), ErrCountryCodeNotPresent)
+}
+
 func TestParseInvalid(t *testing.T) {
 	for _, cs := range invalidCases {
 		t.Run(cs.iban, func(t *testing.T) {
@@ -480,6 +783,193 @@ func TestMustParseInvalid(t *testing.T) {
 	}
 }
 
+func TestParseNationalCheck(t *testing.T) {
+	for _, cs := range validCases {
+		t.Run(cs.iban, func(t *testing.T) {
+			ib, err := Parse(cs.iban, WithNationalCheck())
+			require.NoError(t, err)
+			require.Equal(t, cs.iban, ib.String())
+		})
+	}
+}
+
+func TestParseNationalCheckInvalid(t *testing.T) {
+	cases := []struct {
+		countryCode string
+		bban        string
+	}{
+		{countryCode: "BE", bban: "539007547035"},
+		{countryCode: "PT", bban: "000201231234567890145"},
+		{countryCode: "SI", bban: "263300012039068"},
+		{countryCode: "FR", bban: "20041010050500013N02606"},
+		{countryCode: "MC", bban: "11222000010123456789031"},
+		{countryCode: "IT", bban: "A0542811101000000123456"},
+		{countryCode: "SM", bban: "U0322509800000000270101"},
+		{countryCode: "ES", bban: "21000418460200051332"},
+		{countryCode: "SK", bban: "11000000002920884961"},
+		{countryCode: "CZ", bban: "08000000292000145399"},
+		{countryCode: "PL", bban: "102010270000042270201111"},
+		{countryCode: "HU", bban: "117730161111101900000000"},
+		{countryCode: "HR", bban: "10010061863000160"},
+		{countryCode: "NO", bban: "86011117948"},
+		{countryCode: "FI", bban: "12345600000786"},
+		{countryCode: "EE", bban: "2200221020145686"},
+		{countryCode: "IS", bban: "0159260076545510730349"},
+	}
+	for _, cs := range cases {
+		t.Run(cs.countryCode+cs.bban, func(t *testing.T) {
+			ib, err := FromBban(cs.countryCode, cs.bban)
+			require.NoError(t, err)
+
+			_, err = Parse(ib.String())
+			require.NoError(t, err)
+
+			_, err = Parse(ib.String(), WithNationalCheck())
+			require.ErrorIs(t, err, ErrInvalidNationalCheckDigit)
+			require.Equal(t, []error{err}, ValidateAll(ib.String(), WithNationalCheck()))
+
+			_, err = FromBban(cs.countryCode, cs.bban, WithNationalCheck())
+			require.ErrorIs(t, err, ErrInvalidNationalCheckDigit)
+		})
+	}
+}
+
+func TestFromBban(t *testing.T) {
+	for _, cs := range validCases {
+		t.Run(cs.iban, func(t *testing.T) {
+			ib, err := FromBban(cs.countryCode, cs.bban)
+			require.NoError(t, err)
+			require.Equal(t, cs.iban, ib.String())
+			require.Equal(t, cs.checkDigit, ib.CheckDigit())
+			require.Equal(t, cs.bankCode, ib.BankCode())
+			require.Equal(t, cs.accountNumber, ib.AccountNumber())
+		})
+	}
+}
+
+func TestFromBbanInvalid(t *testing.T) {
+	cases := []struct {
+		countryCode string
+		bban        string
+		err         error
+	}{
+		{countryCode: "XX", bban: "539007547034", err: ErrCountryCodeNotPresent},
+		{countryCode: "be", bban: "539007547034", err: ErrCountryCodeNotUpper},
+		{countryCode: "BE", bban: "53900754703", err: ErrInvalidBbanLength},
+		{countryCode: "BE", bban: "5390075470AB", err: ErrInvalidBbanPart},
+	}
+	for _, cs := range cases {
+		t.Run(cs.countryCode+cs.bban, func(t *testing.T) {
+			ib, err := FromBban(cs.countryCode, cs.bban)
+			require.Nil(t, ib)
+			require.ErrorIs(t, err, cs.err)
+		})
+	}
+}
+
+func TestFromParts(t *testing.T) {
+	for _, cs := range validCases {
+		t.Run(cs.iban, func(t *testing.T) {
+			parts := make(map[bban.EntryType]string)
+			for entry, value := range map[bban.EntryType]string{
+				bban.BankCode:             cs.bankCode,
+				bban.BranchCode:           cs.branchCode,
+				bban.AccountNumber:        cs.accountNumber,
+				bban.AccountNumberPrefix:  cs.accountNumberPrefix,
+				bban.NationalCheckDigit:   cs.nationalCheckDigit,
+				bban.AccountType:          cs.accountType,
+				bban.OwnerAccountType:     cs.ownerAccountType,
+				bban.IdentificationNumber: cs.identificationNumber,
+				bban.Currency:             cs.currency,
+			} {
+				if value != "" {
+					parts[entry] = value
+				}
+			}
+			ib, err := FromParts(cs.countryCode, parts)
+			require.NoError(t, err)
+			require.Equal(t, cs.iban, ib.String())
+		})
+	}
+}
+
+func TestFromPartsPadding(t *testing.T) {
+	ib, err := FromParts("SK", map[bban.EntryType]string{
+		bban.BankCode:      "1100",
+		bban.AccountNumber: "2920884960",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "SK0611000000002920884960", ib.String())
+
+	ib, err = FromParts("CR", map[bban.EntryType]string{
+		bban.BankCode:      "152",
+		bban.AccountNumber: "02001026284066",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "CR05015202001026284066", ib.String())
+}
+
+func TestFromPartsInvalid(t *testing.T) {
+	cases := []struct {
+		name        string
+		countryCode string
+		parts       map[bban.EntryType]string
+		err         error
+	}{
+		{
+			name:        "country",
+			countryCode: "XX",
+			parts:       map[bban.EntryType]string{},
+			err:         ErrCountryCodeNotPresent,
+		},
+		{
+			name:        "missing",
+			countryCode: "SK",
+			parts:       map[bban.EntryType]string{bban.BankCode: "1100"},
+			err:         ErrMissingBbanPart,
+		},
+		{
+			name:        "long",
+			countryCode: "SK",
+			parts:       map[bban.EntryType]string{bban.BankCode: "11000", bban.AccountNumber: "1"},
+			err:         ErrInvalidBbanPart,
+		},
+		{
+			name:        "chars",
+			countryCode: "GB",
+			parts:       map[bban.EntryType]string{bban.BankCode: "NWB", bban.BranchCode: "601613", bban.AccountNumber: "31926819"},
+			err:         ErrInvalidBbanPart,
+		},
+		{
+			name:        "unexpected",
+			countryCode: "AT",
+			parts:       map[bban.EntryType]string{bban.BankCode: "19043", bban.BranchCode: "1", bban.AccountNumber: "00234573201"},
+			err:         ErrUnexpectedBbanPart,
+		},
+	}
+	for _, cs := range cases {
+		t.Run(cs.name, func(t *testing.T) {
+			ib, err := FromParts(cs.countryCode, cs.parts)
+			require.Nil(t, ib)
+			require.ErrorIs(t, err, cs.err)
+		})
+	}
+}
+
+func TestFromPartsUnexpected(t *testing.T) {
+	_, err := FromParts("AT", map[bban.EntryType]string{
+		bban.BankCode:      "19043",
+		bban.AccountNumber: "00234573201",
+		bban.Currency:      "EUR",
+		bban.BranchCode:    "1",
+	})
+	require.EqualError(t, err, "iban: unexpected bban part: BranchCode at offset 4")
+
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	require.Equal(t, CodeUnexpectedBbanPart, verr.Code)
+}
+
 func BenchmarkValidate(b *testing.B) {
 	b.ReportAllocs()
 	b.SetBytes(2)
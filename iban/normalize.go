@@ -0,0 +1,190 @@
+package iban
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/jbub/banking/bban"
+)
+
+// Transformation represents a change applied to iban when normalizing it
+// to the electronic format.
+type Transformation int
+
+const (
+	// RemovedLabel represents removal of leading IBAN label.
+	RemovedLabel Transformation = iota
+
+	// RemovedSpaces represents removal of spaces and other whitespace.
+	RemovedSpaces
+
+	// RemovedNonBreakingSpaces represents removal of non-breaking spaces.
+	RemovedNonBreakingSpaces
+
+	// RemovedDashes represents removal of dashes.
+	RemovedDashes
+
+	// RemovedDots represents removal of dots.
+	RemovedDots
+
+	// Uppercased represents conversion of lowercase letters to uppercase.
+	Uppercased
+)
+
+// labelPrefix is an optional label used in front of print format iban.
+const labelPrefix = "IBAN"
+
+// transformationSet holds transformations applied during normalization.
+type transformationSet [Uppercased + 1]bool
+
+// String returns text representation of Transformation.
+func (t Transformation) String() string {
+	switch t {
+	case RemovedLabel:
+		return "RemovedLabel"
+	case RemovedSpaces:
+		return "RemovedSpaces"
+	case RemovedNonBreakingSpaces:
+		return "RemovedNonBreakingSpaces"
+	case RemovedDashes:
+		return "RemovedDashes"
+	case RemovedDots:
+		return "RemovedDots"
+	case Uppercased:
+		return "Uppercased"
+	}
+	return ""
+}
+
+// ParseLenient normalizes human entered or print format iban to the
+// electronic format, validates it and creates new iban code using default
+// country registry. Applied transformations are returned in the order they
+// are declared. Lowercase letters are uppercased in country code and in
+// bban parts of registry classes a and c, blanks in bban parts of registry
+// class e are kept, so the iban is returned in the uppercase electronic
+// format.
+func ParseLenient(value string, opts ...Option) (*Iban, []Transformation, error) {
+	return defaultParserWith(opts).ParseLenient(value)
+}
+
+// normalize removes label and separators from value, blanks in bban parts
+// of registry class e are kept. Bban parts of country found by lookup are
+// returned, they are nil if country is not known.
+func normalize(value string, lookup func(code string) (bban.Structure, bool)) (string, []bban.Part, transformationSet) {
+	var applied transformationSet
+
+	trimmed := strings.TrimLeftFunc(value, isSeparator)
+	if hasLabel(trimmed) {
+		markSeparators(value[:len(value)-len(trimmed)], &applied)
+		value = strings.TrimLeft(trimmed[len(labelPrefix):], ":")
+		applied[RemovedLabel] = true
+	}
+
+	var (
+		parts  []bban.Part
+		looked bool
+		sb     strings.Builder
+	)
+	sb.Grow(len(value))
+	for _, r := range value {
+		if !looked && sb.Len() >= checkDigitOffset {
+			if struc, ok := lookup(strings.ToUpper(sb.String()[:checkDigitOffset])); ok {
+				parts = struc.Parts()
+			}
+			looked = true
+		}
+		if r == ' ' && inClass(parts, sb.Len(), bban.Space) {
+			sb.WriteRune(r)
+			continue
+		}
+		if isSeparator(r) || isDash(r) || r == '.' {
+			markSeparator(r, &applied)
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String(), parts, applied
+}
+
+// uppercase converts lowercase letters of country code and of bban parts
+// of registry classes a and c, letters of other parts keep their case.
+// Bban is not changed if parts of country are not known.
+func uppercase(value string, parts []bban.Part, applied *transformationSet) string {
+	b := []byte(value)
+	end := bbanOffset
+	if end > len(b) {
+		end = len(b)
+	}
+	upperBytes(b[:end], applied)
+
+	offset := bbanOffset
+	for _, part := range parts {
+		if offset >= len(b) {
+			break
+		}
+		end := offset + part.Length
+		if end > len(b) {
+			end = len(b)
+		}
+		if hasClass(part, bban.AlphaUpper) || hasClass(part, bban.AlphaNum) {
+			upperBytes(b[offset:end], applied)
+		}
+		offset = end
+	}
+	return string(b)
+}
+
+func upperBytes(b []byte, applied *transformationSet) {
+	for idx, c := range b {
+		if 'a' <= c && c <= 'z' {
+			b[idx] = c - ('a' - 'A')
+			applied[Uppercased] = true
+		}
+	}
+}
+
+// list returns applied transformations in the order they are declared.
+func (s transformationSet) list() []Transformation {
+	var trans []Transformation
+	for t, ok := range s {
+		if ok {
+			trans = append(trans, Transformation(t))
+		}
+	}
+	return trans
+}
+
+func hasLabel(value string) bool {
+	return len(value) >= len(labelPrefix) && strings.EqualFold(value[:len(labelPrefix)], labelPrefix)
+}
+
+func markSeparators(value string, applied *transformationSet) {
+	for _, r := range value {
+		markSeparator(r, applied)
+	}
+}
+
+func markSeparator(r rune, applied *transformationSet) {
+	switch {
+	case isNonBreakingSpace(r):
+		applied[RemovedNonBreakingSpaces] = true
+	case unicode.IsSpace(r):
+		applied[RemovedSpaces] = true
+	case isDash(r):
+		applied[RemovedDashes] = true
+	case r == '.':
+		applied[RemovedDots] = true
+	}
+}
+
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || isNonBreakingSpace(r)
+}
+
+func isNonBreakingSpace(r rune) bool {
+	return r == '\u00a0' || r == '\u2007' || r == '\u202f'
+}
+
+func isDash(r rune) bool {
+	return r == '-' || ('\u2010' <= r && r <= '\u2015')
+}
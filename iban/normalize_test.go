@@ -0,0 +1,148 @@
+package iban
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/bban"
+	"github.com/jbub/banking/country"
+)
+
+var (
+	upperLetters = bban.MustNewCharType("UpperLetters", 'a', func(s string) bool {
+		return bban.AlphaUpper.Validate(s) && s != "XXXX"
+	})
+
+	lenientCases = []struct {
+		in    string
+		want  string
+		trans []Transformation
+	}{
+		{
+			in:    "BE68539007547034",
+			want:  "BE68539007547034",
+			trans: nil,
+		},
+		{
+			in:    "BE68 5390 0754 7034",
+			want:  "BE68539007547034",
+			trans: []Transformation{RemovedSpaces},
+		},
+		{
+			in:    "  be68 5390 0754 7034\n",
+			want:  "BE68539007547034",
+			trans: []Transformation{RemovedSpaces, Uppercased},
+		},
+		{
+			in:    "IBAN BE68 5390 0754 7034",
+			want:  "BE68539007547034",
+			trans: []Transformation{RemovedLabel, RemovedSpaces},
+		},
+		{
+			in:    "iban: BE68539007547034",
+			want:  "BE68539007547034",
+			trans: []Transformation{RemovedLabel, RemovedSpaces},
+		},
+		{
+			in:    "BE68\u00a05390\u00a00754\u202f7034",
+			want:  "BE68539007547034",
+			trans: []Transformation{RemovedNonBreakingSpaces},
+		},
+		{
+			in:    "BE68-5390-0754-7034",
+			want:  "BE68539007547034",
+			trans: []Transformation{RemovedDashes},
+		},
+		{
+			in:    "GB29.NWBK.6016.1331.9268.19",
+			want:  "GB29NWBK60161331926819",
+			trans: []Transformation{RemovedDots},
+		},
+		{
+			in:    "gb29 nwbk 6016 1331 9268 19",
+			want:  "GB29NWBK60161331926819",
+			trans: []Transformation{RemovedSpaces, Uppercased},
+		},
+		{
+			in:    "BY13NBRB3600900000002z00ab00",
+			want:  "BY13NBRB3600900000002Z00AB00",
+			trans: []Transformation{Uppercased},
+		},
+		{
+			in:    "by13 nbrb 3600 9000 0000 2z00 ab00",
+			want:  "BY13NBRB3600900000002Z00AB00",
+			trans: []Transformation{RemovedSpaces, Uppercased},
+		},
+	}
+)
+
+func TestParseLenient(t *testing.T) {
+	for _, cs := range lenientCases {
+		t.Run(cs.in, func(t *testing.T) {
+			ib, trans, err := ParseLenient(cs.in)
+			require.NoError(t, err)
+			require.Equal(t, cs.want, ib.String())
+			require.Equal(t, cs.trans, trans)
+		})
+	}
+}
+
+func TestParseLenientInvalid(t *testing.T) {
+	ib, trans, err := ParseLenient("BE68 5390 0754 7035")
+	require.Nil(t, ib)
+	require.Equal(t, []Transformation{RemovedSpaces}, trans)
+	require.ErrorIs(t, err, ErrInvalidCheckDigit)
+}
+
+func TestParseLenientSpace(t *testing.T) {
+	p := NewParser(country.NewRegistry(country.Country{
+		Name:       "Pilot",
+		Alpha2Code: "XT",
+		Alpha3Code: "XTT",
+		Structure: bban.NewStructure(
+			bban.NewAccountNumber(10, bban.Num),
+			bban.NewPadding(2, bban.Space),
+		),
+	}))
+	ibn, err := p.FromBban("XT", "0417164300  ")
+	require.NoError(t, err)
+
+	lenient, trans, err := p.ParseLenient(ibn.PrintString())
+	require.NoError(t, err)
+	require.Equal(t, ibn.String(), lenient.String())
+	require.Equal(t, []Transformation{RemovedSpaces}, trans)
+}
+
+func TestParseLenientCustomCharType(t *testing.T) {
+	nl, _ := country.Get("NL")
+	nl.Structure = bban.NewStructure(
+		bban.NewBankCode(4, upperLetters),
+		bban.NewAccountNumber(10, bban.Num),
+	)
+	p := NewParser(country.NewRegistry(nl))
+
+	ib, trans, err := p.ParseLenient("nl91 abna 0417 1643 00")
+	require.NoError(t, err)
+	require.Equal(t, "NL91ABNA0417164300", ib.String())
+	require.Equal(t, []Transformation{RemovedSpaces, Uppercased}, trans)
+}
+
+func TestRemoveBlanks(t *testing.T) {
+	struc := bban.NewStructure(
+		bban.NewAccountNumber(4, bban.AlphaNum),
+		bban.NewPadding(2, bban.Space),
+	)
+	require.Equal(t, "XT001234", removeBlanks("XT001234  ", struc))
+	require.Equal(t, "XT0012 4", removeBlanks("XT0012 4  ", struc))
+
+	_, err := calculateMod("XT0012 4", "XT")
+	require.Equal(t, ErrInvalidIbanModulo, err)
+	require.Equal(t, 6, invalidModuloOffset("XT0012 4  ", struc))
+}
+
+func TestTransformationString(t *testing.T) {
+	require.Equal(t, "RemovedLabel", RemovedLabel.String())
+	require.Equal(t, "Uppercased", Uppercased.String())
+	require.Equal(t, "", Transformation(-1).String())
+}
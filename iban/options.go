@@ -0,0 +1,34 @@
+package iban
+
+// Option configures parsing and validation of iban.
+type Option func(*options)
+
+type options struct {
+	nationalCheck bool
+	experimental  bool
+}
+
+// WithNationalCheck enables validation of national check digits for
+// countries with a known national check digit algorithm.
+func WithNationalCheck() Option {
+	return func(o *options) {
+		o.nationalCheck = true
+	}
+}
+
+// WithExperimental enables countries of country.Experimental registry
+// which are not part of the official IBAN registry. Countries of parser
+// registry take precedence over experimental countries.
+func WithExperimental() Option {
+	return func(o *options) {
+		o.experimental = true
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
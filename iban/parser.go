@@ -0,0 +1,217 @@
+package iban
+
+import (
+	"time"
+
+	"github.com/jbub/banking/bban"
+	"github.com/jbub/banking/country"
+)
+
+// Parser parses and validates iban codes using countries held in a
+// country.Registry.
+type Parser struct {
+	reg  *country.Registry
+	opts options
+}
+
+// defaultParser is used by package level functions called without options.
+var defaultParser = NewParser(country.Default())
+
+// NewParser creates a new Parser using given registry and options.
+func NewParser(reg *country.Registry, opts ...Option) *Parser {
+	return &Parser{
+		reg:  reg,
+		opts: newOptions(opts),
+	}
+}
+
+// defaultParserWith returns parser using default country registry with
+// given options, default parser is reused if there are no options.
+func defaultParserWith(opts []Option) *Parser {
+	if len(opts) == 0 {
+		return defaultParser
+	}
+	return NewParser(country.Default(), opts...)
+}
+
+// Validate validates iban code.
+func (p *Parser) Validate(value string) error {
+	_, err := p.validate(value)
+	return err
+}
+
+// ValidateAt validates iban code against bban structure of its country
+// effective at given time. Country not present in registry at given time
+// is reported as ErrCountryCodeNotPresent.
+func (p *Parser) ValidateAt(value string, t time.Time) error {
+	_, err := p.validateWith(value, func(code string) (bban.Structure, bool) {
+		if struc, ok := p.reg.GetBbanStructureAt(code, t); ok {
+			return struc, true
+		}
+		if p.opts.experimental {
+			return country.Experimental().GetBbanStructureAt(code, t)
+		}
+		return bban.Structure{}, false
+	})
+	return err
+}
+
+// ValidateAll validates iban code and returns all found validation errors,
+// nil is returned for valid iban code.
+func (p *Parser) ValidateAll(value string) []error {
+	if err := validateMinLength(value); err != nil {
+		return []error{err}
+	}
+
+	code := extractCountryCode(value)
+	if err := validateCountryCode(code); err != nil {
+		return []error{err}
+	}
+
+	struc, ok := p.lookup(code)
+	if !ok {
+		return []error{newError(CodeCountryCodeNotPresent, 0, ErrCountryCodeNotPresent)}
+	}
+
+	bbn := extractBban(value)
+	errs := validateBbanAll(bbn, struc)
+	if err := validateCheckDigit(value, code, struc); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 && p.opts.nationalCheck {
+		if err := validateNationalCheckDigit(bbn, struc); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Parse validates and creates new iban code.
+func (p *Parser) Parse(value string) (*Iban, error) {
+	struc, err := p.validate(value)
+	if err != nil {
+		return nil, err
+	}
+	return &Iban{
+		value: value,
+		struc: struc,
+	}, nil
+}
+
+// ParseLenient normalizes human entered or print format iban to the
+// electronic format, validates it and creates new iban code. Applied
+// transformations are returned in the order they are declared. Lowercase
+// letters are uppercased in country code and in bban parts of registry
+// classes a and c, blanks in bban parts of registry class e are kept, so
+// the iban is returned in the uppercase electronic format.
+func (p *Parser) ParseLenient(value string) (*Iban, []Transformation, error) {
+	normalized, parts, applied := normalize(value, p.lookup)
+	normalized = uppercase(normalized, parts, &applied)
+
+	ibn, err := p.Parse(normalized)
+	if err != nil {
+		return nil, applied.list(), err
+	}
+	return ibn, applied.list(), nil
+}
+
+// FromBban creates new iban code from given country code and bban,
+// check digit is calculated.
+func (p *Parser) FromBban(countryCode string, bbn string) (*Iban, error) {
+	if err := validateCountryCode(countryCode); err != nil {
+		return nil, err
+	}
+
+	struc, ok := p.lookup(countryCode)
+	if !ok {
+		return nil, newError(CodeCountryCodeNotPresent, 0, ErrCountryCodeNotPresent)
+	}
+
+	if err := validateBban(bbn, struc); err != nil {
+		return nil, err
+	}
+
+	if p.opts.nationalCheck {
+		if err := validateNationalCheckDigit(bbn, struc); err != nil {
+			return nil, err
+		}
+	}
+
+	value, err := buildIban(countryCode, bbn, struc)
+	if err != nil {
+		return nil, err
+	}
+	return &Iban{
+		value: value,
+		struc: struc,
+	}, nil
+}
+
+// FromParts creates new iban code from given country code and bban parts
+// keyed by their entry type. Parts are left padded with zeros to their
+// length, padding and missing account number prefix parts are filled
+// with zeros.
+func (p *Parser) FromParts(countryCode string, parts map[bban.EntryType]string) (*Iban, error) {
+	if err := validateCountryCode(countryCode); err != nil {
+		return nil, err
+	}
+
+	struc, ok := p.lookup(countryCode)
+	if !ok {
+		return nil, newError(CodeCountryCodeNotPresent, 0, ErrCountryCodeNotPresent)
+	}
+
+	bbn, err := composeBban(parts, struc)
+	if err != nil {
+		return nil, err
+	}
+	return p.FromBban(countryCode, bbn)
+}
+
+func (p *Parser) validate(value string) (bban.Structure, error) {
+	return p.validateWith(value, p.lookup)
+}
+
+// lookup returns bban structure of country from parser registry, falling
+// back to experimental countries if enabled.
+func (p *Parser) lookup(code string) (bban.Structure, bool) {
+	if struc, ok := p.reg.GetBbanStructure(code); ok {
+		return struc, true
+	}
+	if p.opts.experimental {
+		return country.Experimental().GetBbanStructure(code)
+	}
+	return bban.Structure{}, false
+}
+
+func (p *Parser) validateWith(value string, lookup func(code string) (bban.Structure, bool)) (bban.Structure, error) {
+	if err := validateMinLength(value); err != nil {
+		return bban.Structure{}, err
+	}
+
+	code := extractCountryCode(value)
+	if err := validateCountryCode(code); err != nil {
+		return bban.Structure{}, err
+	}
+
+	struc, ok := lookup(code)
+	if !ok {
+		return bban.Structure{}, newError(CodeCountryCodeNotPresent, 0, ErrCountryCodeNotPresent)
+	}
+
+	bbn := extractBban(value)
+	if err := validateBban(bbn, struc); err != nil {
+		return struc, err
+	}
+
+	if err := validateCheckDigit(value, code, struc); err != nil {
+		return struc, err
+	}
+
+	if p.opts.nationalCheck {
+		if err := validateNationalCheckDigit(bbn, struc); err != nil {
+			return struc, err
+		}
+	}
+	return struc, nil
+}
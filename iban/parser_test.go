@@ -0,0 +1,206 @@
+package iban
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/bban"
+	"github.com/jbub/banking/country"
+)
+
+var (
+	nonZeroDigits = bban.MustNewCharType("NonZeroDigits", 'n', func(s string) bool {
+		return bban.Num.Validate(s) && strings.Trim(s, "0") != ""
+	})
+
+	pilotCountry = country.Country{
+		Name:       "Pilot",
+		Alpha2Code: "XT",
+		Alpha3Code: "XTT",
+		Structure: bban.NewStructure(
+			bban.NewBankCode(4, bban.AlphaUpper),
+			bban.NewAccountNumber(10, bban.Num),
+		),
+	}
+)
+
+func TestParserRegister(t *testing.T) {
+	reg := country.NewRegistry()
+	p := NewParser(reg)
+
+	_, err := p.FromBban("XT", "ABNA0417164300")
+	require.ErrorIs(t, err, ErrCountryCodeNotPresent)
+
+	require.NoError(t, reg.Register(pilotCountry))
+	ibn, err := p.FromBban("XT", "ABNA0417164300")
+	require.NoError(t, err)
+	require.Equal(t, "ABNA", ibn.BankCode())
+	require.Equal(t, "0417164300", ibn.AccountNumber())
+
+	parsed, err := p.Parse(ibn.String())
+	require.NoError(t, err)
+	require.Equal(t, ibn.String(), parsed.String())
+	require.NoError(t, p.Validate(ibn.String()))
+	require.Empty(t, p.ValidateAll(ibn.String()))
+
+	// pilot country is not visible to the default registry
+	require.ErrorIs(t, Validate(ibn.String()), ErrCountryCodeNotPresent)
+
+	lenient, trans, err := p.ParseLenient(printFormat(ibn.String()))
+	require.NoError(t, err)
+	require.Equal(t, ibn.String(), lenient.String())
+	require.Equal(t, []Transformation{RemovedSpaces}, trans)
+
+	fromParts, err := p.FromParts("XT", map[bban.EntryType]string{
+		bban.BankCode:      "ABNA",
+		bban.AccountNumber: "417164300",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ibn.String(), fromParts.String())
+}
+
+func TestParserRemove(t *testing.T) {
+	at, _ := country.Get("AT")
+	reg := country.NewRegistry(at)
+	p := NewParser(reg)
+	require.NoError(t, p.Validate("AT611904300234573201"))
+
+	reg.Remove("AT")
+	require.ErrorIs(t, p.Validate("AT611904300234573201"), ErrCountryCodeNotPresent)
+	errs := p.ValidateAll("AT611904300234573201")
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], ErrCountryCodeNotPresent)
+}
+
+func TestParserOverride(t *testing.T) {
+	reg := country.NewRegistry(country.Default().Countries()...)
+	p := NewParser(reg, WithNationalCheck())
+	require.NoError(t, p.Validate("BE68539007547034"))
+	require.ErrorIs(t, p.Validate("BE41539007547035"), ErrInvalidNationalCheckDigit)
+
+	be, _ := reg.Lookup("BE")
+	be.Structure = bban.NewStructure(
+		bban.NewBankCode(3, bban.Num),
+		bban.NewAccountNumber(9, bban.Num),
+	)
+	require.NoError(t, reg.Register(be))
+	require.NoError(t, p.Validate("BE41539007547035"))
+	require.ErrorIs(t, Validate("BE41539007547035", WithNationalCheck()), ErrInvalidNationalCheckDigit)
+}
+
+func TestParserReplace(t *testing.T) {
+	at, _ := country.Get("AT")
+	reg := country.NewRegistry(at)
+	p := NewParser(reg)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			reg.Replace(at, pilotCountry)
+			reg.Replace(at)
+		}()
+		go func() {
+			defer wg.Done()
+			require.NoError(t, p.Validate("AT611904300234573201"))
+		}()
+	}
+	wg.Wait()
+	require.False(t, reg.Exists("XT"))
+}
+
+func TestParserSpace(t *testing.T) {
+	reg := country.NewRegistry(country.Country{
+		Name:       "Pilot",
+		Alpha2Code: "XT",
+		Alpha3Code: "XTT",
+		Structure: bban.NewStructure(
+			bban.NewAccountNumber(10, bban.Num),
+			bban.NewPadding(2, bban.Space),
+		),
+	})
+	p := NewParser(reg)
+
+	ibn, err := p.FromBban("XT", "0417164300  ")
+	require.NoError(t, err)
+	require.Equal(t, "0417164300", ibn.AccountNumber())
+
+	parsed, err := p.Parse(ibn.String())
+	require.NoError(t, err)
+	require.Equal(t, ibn.String(), parsed.String())
+
+	_, err = p.FromBban("XT", "041716430000")
+	require.ErrorIs(t, err, ErrInvalidBbanPart)
+}
+
+func TestParserCustomCharType(t *testing.T) {
+	nl, _ := country.Get("NL")
+	nl.Structure = bban.NewStructure(
+		bban.NewBankCode(4, bban.AlphaUpper),
+		bban.NewAccountNumber(10, nonZeroDigits),
+	)
+	p := NewParser(country.NewRegistry(nl))
+
+	require.NoError(t, p.Validate("NL91ABNA0417164300"))
+
+	_, err := p.FromBban("NL", "ABNA0000000000")
+	require.ErrorIs(t, err, ErrInvalidBbanPart)
+
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	require.Equal(t, "NonZeroDigits", verr.CharType)
+}
+
+func TestParserExperimental(t *testing.T) {
+	cases := []struct {
+		iban     string
+		bankCode string
+	}{
+		{"DZ580002100001113000000570", ""},
+		{"AO06004400006729503010102", "0044"},
+		{"BJ66BJ0610100100144390000769", "BJ061"},
+		{"BF42BF0840101300463574000390", "BF084"},
+		{"CM2110003001000500000605306", "10003"},
+		{"CV64000300004547069110176", "0003"},
+		{"IR580540105180021273113007", "054"},
+		{"CI93CI0080111301134291200589", "CI008"},
+		{"MG4600005030071289421016045", "00005"},
+		{"ML13ML0160120102600100668497", "ML016"},
+		{"MZ59000301080016367102371", "0003"},
+		{"NE58NE0380100100130305000268", "NE038"},
+		{"SN08SN0100152000048500003035", "SN010"},
+		{"TG53TG0090604310346500400070", "TG009"},
+	}
+	for _, cs := range cases {
+		t.Run(cs.iban, func(t *testing.T) {
+			require.ErrorIs(t, Validate(cs.iban), ErrCountryCodeNotPresent)
+
+			ibn, err := Parse(cs.iban, WithExperimental())
+			require.NoError(t, err)
+			require.Equal(t, cs.bankCode, ibn.BankCode())
+
+			ibn, err = FromBban(cs.iban[:2], cs.iban[4:], WithExperimental())
+			require.NoError(t, err)
+			require.Equal(t, cs.iban, ibn.String())
+		})
+	}
+}
+
+func TestParserExperimentalPrecedence(t *testing.T) {
+	reg := country.NewRegistry(country.Country{
+		Alpha2Code: "DZ",
+		Structure:  bban.NewStructure(bban.NewBankCode(3, bban.Num), bban.NewAccountNumber(19, bban.Num)),
+	})
+	ibn, err := NewParser(reg, WithExperimental()).Parse("DZ580002100001113000000570")
+	require.NoError(t, err)
+	require.Equal(t, "000", ibn.BankCode())
+
+	p := NewParser(country.NewRegistry(), WithExperimental())
+	require.NoError(t, p.ValidateAt("SN08SN0100152000048500003035", time.Now()))
+	require.ErrorIs(t, p.Validate("XT08SN0100152000048500003035"), ErrCountryCodeNotPresent)
+}
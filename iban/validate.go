@@ -2,8 +2,10 @@ package iban
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/jbub/banking/bban"
+	"github.com/jbub/banking/national"
 )
 
 const (
@@ -13,62 +15,185 @@ const (
 	// modCheck represents value used in mod check.
 	modCheck = 98
 
-	// modValue represents value used in mod check.
-	modValue = 97
-
-	// modMax is the maximum value allowed in mod check.
-	modMax = 999999999
-
 	// defaultCheckDigit is digit used in digit check.
 	defaultCheckDigit = "00"
+
+	// padChar is used to left pad bban parts.
+	padChar = "0"
+
+	// checkDigitOffset represents offset of check digit in iban.
+	checkDigitOffset = 2
+
+	// bbanOffset represents offset of bban in iban.
+	bbanOffset = 4
 )
 
 func validateMinLength(value string) error {
 	if len(value) < minIbanSize {
-		return ErrIbanTooShort
+		return &ValidationError{
+			Code:   CodeIbanTooShort,
+			Offset: len(value),
+			Length: minIbanSize,
+			Err:    ErrIbanTooShort,
+		}
 	}
 	return nil
 }
 
 func validateCountryCode(code string) error {
-	for _, r := range code {
+	for idx, r := range code {
 		if 'a' <= r && r <= 'z' {
-			return ErrCountryCodeNotUpper
+			return newError(CodeCountryCodeNotUpper, idx, ErrCountryCodeNotUpper)
 		}
-		if 'A' > r && r > 'Z' {
-			return ErrCountryCodeNotAlpha
+		if 'A' > r || r > 'Z' {
+			return newError(CodeCountryCodeNotAlpha, idx, ErrCountryCodeNotAlpha)
 		}
 	}
 	return nil
 }
 
-func validateCheckDigit(value string, code string) error {
-	calc, err := calculateCheckDigit(value, code)
+func validateCheckDigit(value string, code string, struc bban.Structure) error {
+	calc, err := calculateCheckDigit(removeBlanks(value, struc), code)
 	if err != nil {
-		return err
+		return newError(CodeInvalidIbanModulo, invalidModuloOffset(value, struc), err)
 	}
 
 	if digit := extractCheckDigit(value); digit != calc {
-		return ErrInvalidCheckDigit
+		return &ValidationError{
+			Code:       CodeInvalidCheckDigit,
+			Offset:     checkDigitOffset,
+			Length:     len(calc),
+			CheckDigit: calc,
+			Err:        ErrInvalidCheckDigit,
+		}
 	}
 	return nil
 }
 
 func validateBban(bbn string, struc bban.Structure) error {
 	if len(bbn) != struc.Length() {
-		return ErrInvalidBbanLength
+		return &ValidationError{
+			Code:   CodeInvalidBbanLength,
+			Offset: bbanOffset,
+			Length: struc.Length(),
+			Err:    ErrInvalidBbanLength,
+		}
 	}
 
 	var offset int
 	for _, part := range struc.Parts() {
 		if value := bbn[offset : offset+part.Length]; !part.Validate(value) {
-			return ErrInvalidBbanPart
+			return newPartError(CodeInvalidBbanPart, bbanOffset+offset, part, ErrInvalidBbanPart)
 		}
 		offset += part.Length
 	}
 	return nil
 }
 
+func validateNationalCheckDigit(bbn string, struc bban.Structure) error {
+	if struc.Check(bbn) {
+		return nil
+	}
+
+	offset := bbanOffset
+	for _, part := range struc.Parts() {
+		if part.EntryType == bban.NationalCheckDigit {
+			return newPartError(CodeInvalidNationalCheckDigit, offset, part, ErrInvalidNationalCheckDigit)
+		}
+		offset += part.Length
+	}
+	return newError(CodeInvalidNationalCheckDigit, bbanOffset, ErrInvalidNationalCheckDigit)
+}
+
+func validateBbanAll(bbn string, struc bban.Structure) []error {
+	var errs []error
+	if len(bbn) != struc.Length() {
+		errs = append(errs, &ValidationError{
+			Code:   CodeInvalidBbanLength,
+			Offset: bbanOffset,
+			Length: struc.Length(),
+			Err:    ErrInvalidBbanLength,
+		})
+	}
+
+	var offset int
+	for _, part := range struc.Parts() {
+		if offset+part.Length > len(bbn) {
+			break
+		}
+		if value := bbn[offset : offset+part.Length]; !part.Validate(value) {
+			errs = append(errs, newPartError(CodeInvalidBbanPart, bbanOffset+offset, part, ErrInvalidBbanPart))
+		}
+		offset += part.Length
+	}
+	return errs
+}
+
+func composeBban(parts map[bban.EntryType]string, struc bban.Structure) (string, error) {
+	if err := validateEntryTypes(parts, struc); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.Grow(struc.Length())
+
+	for _, part := range struc.Parts() {
+		offset := bbanOffset + sb.Len()
+		value, ok := parts[part.EntryType]
+		if !ok && !part.EntryType.Optional() {
+			return "", newPartError(CodeMissingBbanPart, offset, part, ErrMissingBbanPart)
+		}
+		if len(value) > part.Length {
+			return "", newPartError(CodeInvalidBbanPart, offset, part, ErrInvalidBbanPart)
+		}
+
+		value = strings.Repeat(padChar, part.Length-len(value)) + value
+		if !part.Validate(value) {
+			return "", newPartError(CodeInvalidBbanPart, offset, part, ErrInvalidBbanPart)
+		}
+		sb.WriteString(value)
+	}
+	return sb.String(), nil
+}
+
+// validateEntryTypes reports the first entry type of given parts, in the
+// order of entry type declaration, which is not present in structure.
+func validateEntryTypes(parts map[bban.EntryType]string, struc bban.Structure) error {
+	unexpected := -1
+	for entry := range parts {
+		if !hasEntryType(struc, entry) && (unexpected < 0 || int(entry) < unexpected) {
+			unexpected = int(entry)
+		}
+	}
+	if unexpected < 0 {
+		return nil
+	}
+	part := bban.Part{EntryType: bban.EntryType(unexpected)}
+	return &ValidationError{
+		Code:   CodeUnexpectedBbanPart,
+		Offset: bbanOffset,
+		Part:   &part,
+		Err:    ErrUnexpectedBbanPart,
+	}
+}
+
+func hasEntryType(struc bban.Structure, entry bban.EntryType) bool {
+	for _, part := range struc.Parts() {
+		if part.EntryType == entry {
+			return true
+		}
+	}
+	return false
+}
+
+func buildIban(code string, bbn string, struc bban.Structure) (string, error) {
+	digit, err := calculateCheckDigit(removeBlanks(code+defaultCheckDigit+bbn, struc), code)
+	if err != nil {
+		return "", err
+	}
+	return code + digit + bbn, nil
+}
+
 func calculateCheckDigit(value string, code string) (string, error) {
 	replaced := replaceCheckDigit(value, code)
 	mod, err := calculateMod(replaced, code)
@@ -84,30 +209,70 @@ func calculateCheckDigit(value string, code string) (string, error) {
 }
 
 // https://en.wikipedia.org/wiki/International_Bank_Account_Number#Modulo_operation_on_IBAN
+// Letters are case insensitive, blanks are not valid characters.
 func calculateMod(value string, code string) (int, error) {
-	var total int64
-	for _, c := range reformatIban(value, code) {
-		n := int64(codepointToNum(int(c)))
-		if n < 0 || n > 35 {
-			return 0, ErrInvalidIbanModulo
+	mod, ok := national.Mod97(reformatIban(value, code))
+	if !ok {
+		return 0, ErrInvalidIbanModulo
+	}
+	return mod, nil
+}
+
+func invalidModuloOffset(value string, struc bban.Structure) int {
+	for idx, c := range value {
+		if c == ' ' && inClass(struc.Parts(), idx, bban.Space) {
+			continue
+		}
+		if n := codepointToNum(int(c)); n < 0 || n > 35 {
+			return idx
 		}
+	}
+	return 0
+}
 
-		if n > 9 {
-			total = total*100 + n
-		} else {
-			total = total*10 + n
+// removeBlanks removes blanks of bban parts of registry class e from iban.
+// ISO 7064 defines no value for blanks, so they are left out of the mod-97
+// check, blanks at other positions make the check fail.
+func removeBlanks(value string, struc bban.Structure) string {
+	if strings.IndexByte(value, ' ') < 0 {
+		return value
+	}
+
+	b := make([]byte, 0, len(value))
+	for idx := 0; idx < len(value); idx++ {
+		if value[idx] == ' ' && inClass(struc.Parts(), idx, bban.Space) {
+			continue
 		}
+		b = append(b, value[idx])
+	}
+	return string(b)
+}
 
-		if total > modMax {
-			total %= modValue
+// inClass returns true if iban offset lies within bban part of registry
+// class of given character type.
+func inClass(parts []bban.Part, offset int, class bban.CharType) bool {
+	pos := bbanOffset
+	for _, part := range parts {
+		if offset < pos+part.Length {
+			return offset >= pos && hasClass(part, class)
 		}
+		pos += part.Length
 	}
-	return int(total % modValue), nil
+	return false
+}
+
+// hasClass returns true if part has registry class of given character
+// type, custom character types are matched by their class.
+func hasClass(part bban.Part, class bban.CharType) bool {
+	return part.CharType().Class() == class.Class()
 }
 
 func codepointToNum(c int) int {
-	if c >= '0' && c <= '9' {
+	switch {
+	case c >= '0' && c <= '9':
 		return c - '0'
+	case c >= 'a' && c <= 'z':
+		return c - ('a' - 10)
 	}
 	return c - ('A' - 10)
 }
@@ -133,22 +298,17 @@ func extractBban(value string) string {
 }
 
 func extractBbanPart(value string, struc bban.Structure, entryType bban.EntryType) string {
-	bbn := extractBban(value)
-	var offset int
-	for _, part := range struc.Parts() {
-		value := bbn[offset : offset+part.Length]
-		if part.EntryType == entryType {
-			return value
-		}
-		offset += part.Length
-	}
-	return ""
+	return struc.Extract(extractBban(value), entryType)
 }
 
 func extractBankCode(value string, struc bban.Structure) string {
 	return extractBbanPart(value, struc, bban.BankCode)
 }
 
+func extractAccountNumberPrefix(value string, struc bban.Structure) string {
+	return extractBbanPart(value, struc, bban.AccountNumberPrefix)
+}
+
 func extractBranchCode(value string, struc bban.Structure) string {
 	return extractBbanPart(value, struc, bban.BranchCode)
 }
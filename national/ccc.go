@@ -0,0 +1,69 @@
+package national
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jbub/banking/bban"
+)
+
+const (
+	// cccModulo represents modulo used to calculate Spanish control digits.
+	cccModulo = 11
+
+	// cccLength represents length of values used to calculate control digit.
+	cccLength = 10
+)
+
+// cccWeights holds weights used to calculate Spanish control digits.
+var cccWeights = [cccLength]int{1, 2, 4, 8, 5, 10, 9, 7, 3, 6}
+
+// SpainControlDigits calculates Spanish CCC control digits (DC) from entity
+// (bank code), office (branch code) and account number. The first digit
+// is calculated from zero padded entity and office, the second one from
+// account number.
+func SpainControlDigits(entity string, office string, account string) (string, error) {
+	first, ok := cccDigit(entity + office)
+	if !ok {
+		return "", ErrInvalidValue
+	}
+	second, ok := cccDigit(account)
+	if !ok {
+		return "", ErrInvalidValue
+	}
+	return strconv.Itoa(first) + strconv.Itoa(second), nil
+}
+
+// CheckSpain validates control digits of Spanish bban.
+func CheckSpain(bbn string, struc bban.Structure) bool {
+	dc, err := SpainControlDigits(
+		struc.Extract(bbn, bban.BankCode),
+		struc.Extract(bbn, bban.BranchCode),
+		struc.Extract(bbn, bban.AccountNumber),
+	)
+	return err == nil && dc == struc.Extract(bbn, bban.NationalCheckDigit)
+}
+
+func cccDigit(value string) (int, bool) {
+	if value == "" || len(value) > cccLength {
+		return 0, false
+	}
+
+	value = strings.Repeat("0", cccLength-len(value)) + value
+	var total int
+	for idx, c := range value {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		total += int(c-'0') * cccWeights[idx]
+	}
+
+	switch digit := cccModulo - total%cccModulo; digit {
+	case cccModulo:
+		return 0, true
+	case cccModulo - 1:
+		return 1, true
+	default:
+		return digit, true
+	}
+}
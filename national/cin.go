@@ -0,0 +1,55 @@
+package national
+
+import (
+	"github.com/jbub/banking/bban"
+)
+
+// cinModulo represents modulo used to calculate CIN.
+const cinModulo = 26
+
+// cinOddValues holds values of characters on odd positions, digits share
+// values with letters, so 0 has the same value as A, 1 as B and so on.
+var cinOddValues = [cinModulo]int{
+	1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18,
+	20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23,
+}
+
+// CIN calculates Italian and Sammarinese CIN check character from ABI (bank
+// code), CAB (branch code) and account number. Characters on odd positions
+// are converted using the odd table, characters on even positions using
+// their digit value or alphabet index.
+func CIN(abi string, cab string, account string) (string, error) {
+	if abi == "" || cab == "" || account == "" {
+		return "", ErrInvalidValue
+	}
+
+	var total int
+	for idx, c := range abi + cab + account {
+		var n int
+		switch {
+		case '0' <= c && c <= '9':
+			n = int(c - '0')
+		case 'A' <= c && c <= 'Z':
+			n = int(c - 'A')
+		default:
+			return "", ErrInvalidValue
+		}
+
+		if idx%2 == 0 {
+			total += cinOddValues[n]
+		} else {
+			total += n
+		}
+	}
+	return string(rune('A' + total%cinModulo)), nil
+}
+
+// CheckItaly validates CIN check character of Italian and Sammarinese bban.
+func CheckItaly(bbn string, struc bban.Structure) bool {
+	cin, err := CIN(
+		struc.Extract(bbn, bban.BankCode),
+		struc.Extract(bbn, bban.BranchCode),
+		struc.Extract(bbn, bban.AccountNumber),
+	)
+	return err == nil && cin == struc.Extract(bbn, bban.NationalCheckDigit)
+}
@@ -0,0 +1,25 @@
+package national
+
+import (
+	"github.com/jbub/banking/bban"
+)
+
+// czskModulo represents modulo used in Czech and Slovak account checks.
+const czskModulo = 11
+
+var (
+	// czskPrefixWeights holds weights of Czech and Slovak account number prefix.
+	czskPrefixWeights = []int{10, 5, 8, 4, 2, 1}
+
+	// czskNumberWeights holds weights of Czech and Slovak account number.
+	czskNumberWeights = []int{6, 3, 7, 9, 10, 5, 8, 4, 2, 1}
+)
+
+// CheckCzechSlovakia validates Czech and Slovak bban, weighted sums of both
+// account number prefix and account number must be divisible by 11.
+func CheckCzechSlovakia(bbn string, struc bban.Structure) bool {
+	prefix := struc.Extract(bbn, bban.AccountNumberPrefix)
+	number := struc.Extract(bbn, bban.AccountNumber)
+	return weightedMod(prefix, czskPrefixWeights, czskModulo) == 0 &&
+		weightedMod(number, czskNumberWeights, czskModulo) == 0
+}
@@ -0,0 +1,66 @@
+// Package de provides validation of german account numbers using
+// Bundesbank check digit methods (Prüfzifferberechnungsmethoden) assigned
+// to bank codes in the Bundesbank bank directory (Bankleitzahlendatei).
+package de
+
+import (
+	"errors"
+
+	"github.com/jbub/banking/iban"
+)
+
+// countryCode represents country code of german iban.
+const countryCode = "DE"
+
+// Error codes returned by failures to validate an account number.
+var (
+	ErrNotGerman            = errors.New("de: iban is not german")
+	ErrBankCodeNotPresent   = errors.New("de: bank code does not exist")
+	ErrMethodNotSupported   = errors.New("de: check digit method not supported")
+	ErrInvalidAccountNumber = errors.New("de: invalid account number")
+)
+
+// Validator validates german account numbers.
+type Validator struct {
+	dir *Directory
+}
+
+// NewValidator creates a new Validator using given bank directory.
+func NewValidator(dir *Directory) *Validator {
+	return &Validator{dir: dir}
+}
+
+// Validate validates account number of german iban, returns the check
+// digit method used.
+func (v *Validator) Validate(ibn *iban.Iban) (Method, error) {
+	if ibn.CountryCode() != countryCode {
+		return "", ErrNotGerman
+	}
+	return v.ValidateAccount(ibn.BankCode(), ibn.AccountNumber())
+}
+
+// ValidateAccount validates account number with given bank code, returns
+// the check digit method used.
+func (v *Validator) ValidateAccount(bankCode string, account string) (Method, error) {
+	bank, ok := v.dir.Lookup(bankCode)
+	if !ok {
+		return "", ErrBankCodeNotPresent
+	}
+
+	check, ok := methods[bank.Method]
+	if !ok {
+		return bank.Method, ErrMethodNotSupported
+	}
+
+	a, ok := newAccount(account, bankCode)
+	if !ok || !check(a) {
+		return bank.Method, ErrInvalidAccountNumber
+	}
+	return bank.Method, nil
+}
+
+// Supported returns true if check digit method is implemented.
+func Supported(method Method) bool {
+	_, ok := methods[method]
+	return ok
+}
@@ -0,0 +1,281 @@
+package de
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/iban"
+)
+
+var (
+	accountCases = []struct {
+		bankCode string
+		account  string
+		method   Method
+		valid    bool
+	}{
+		{"10000000", "1234567891", "09", true},
+		{"37040044", "0532013000", "13", true},
+		{"37040044", "5320130", "13", true},
+		{"37040044", "0532014000", "13", false},
+		{"37040044", "05320130001", "13", false},
+		{"37040044", "05320I3000", "13", false},
+	}
+
+	// methodCases holds test account numbers of check digit methods, mostly
+	// the ones published in the Bundesbank specification.
+	methodCases = []struct {
+		method   Method
+		bankCode string
+		valid    []string
+		invalid  []string
+	}{
+		{method: "00", valid: []string{"9290701", "539290858", "1501824", "1501832"}, invalid: []string{"1501833", "9290702"}},
+		{method: "01", valid: []string{"1234567899", "0047111725"}, invalid: []string{"0047111726"}},
+		{method: "02", valid: []string{"1234567897", "3812345600"}, invalid: []string{"0009999990"}},
+		{method: "03", valid: []string{"1234567890", "0047111729"}, invalid: []string{"0047111728"}},
+		{method: "04", valid: []string{"3812345604"}, invalid: []string{"0009999990"}},
+		{method: "05", valid: []string{"9876543213"}, invalid: []string{"9876543212"}},
+		{method: "06", valid: []string{"1234567892", "0009999990", "94012341", "5073321010"}, invalid: []string{"0009999991"}},
+		{method: "07", valid: []string{"3812345609"}, invalid: []string{"1234567890"}},
+		{method: "08", valid: []string{"59999", "9290701"}, invalid: []string{"9290702"}},
+		{method: "09", valid: []string{"1234567890", "1"}},
+		{method: "10", valid: []string{"1234567890", "12345008", "87654008"}, invalid: []string{"1234567891"}},
+		{method: "11", valid: []string{"1234567899"}, invalid: []string{"1234567890"}},
+		{method: "13", valid: []string{"0532013000", "5320130"}, invalid: []string{"0532014000"}},
+		{method: "14", valid: []string{"1234567897", "3987654324"}, invalid: []string{"1234567898"}},
+		{method: "15", valid: []string{"1234567890", "3987654321"}, invalid: []string{"1234567891"}},
+		{method: "16", valid: []string{"94012341"}, invalid: []string{"94012342"}},
+		{method: "17", valid: []string{"0446786040"}, invalid: []string{"0446786140"}},
+		{method: "18", valid: []string{"1234567899", "3987654329"}, invalid: []string{"1234567898"}},
+		{method: "19", valid: []string{"0240334000", "0200520016"}, invalid: []string{"0240334001"}},
+		{method: "20", valid: []string{"1234567896", "3987654324"}, invalid: []string{"1234567897"}},
+		{method: "21", valid: []string{"1234567893", "3987654323"}, invalid: []string{"1234567894"}},
+		{method: "22", valid: []string{"1234567895", "3987654327"}, invalid: []string{"1234567896"}},
+		{method: "23", valid: []string{"1234560890", "3987655320"}, invalid: []string{"1234561890"}},
+		{method: "24", valid: []string{"138301", "1306118605", "3307118608", "9307118603"}, invalid: []string{"138302"}},
+		{method: "25", valid: []string{"521382181"}, invalid: []string{"521382182"}},
+		{method: "26", valid: []string{"0520309001", "1111118111", "0005501024"}, invalid: []string{"0520309101"}},
+		{method: "27", valid: []string{"3145863029", "2938692523", "9290701"}, invalid: []string{"3145863028"}},
+		{method: "28", valid: []string{"19999000", "9130000201"}, invalid: []string{"9130000301"}},
+		{method: "29", valid: []string{"3145863029", "2938692523"}, invalid: []string{"2938692524"}},
+		{method: "30", valid: []string{"1234567892", "3987654324"}, invalid: []string{"1234567893"}},
+		{method: "31", valid: []string{"1000000524", "1000000583"}, invalid: []string{"1000000525"}},
+		{method: "32", valid: []string{"9141405", "1709107983", "0122116979", "0121114867", "9030101192", "9245500460"}, invalid: []string{"9141406"}},
+		{method: "33", valid: []string{"48658", "84956"}, invalid: []string{"48659"}},
+		{method: "34", valid: []string{"9913000700", "9914001000"}, invalid: []string{"9913000800"}},
+		{method: "35", valid: []string{"0000108443", "0000107451", "0000102921", "0000102349", "0000101709", "0000101599"}, invalid: []string{"0000108444"}},
+		{method: "36", valid: []string{"113178", "146666"}, invalid: []string{"113179"}},
+		{method: "37", valid: []string{"624315", "632500"}, invalid: []string{"624316"}},
+		{method: "38", valid: []string{"191919", "1100660"}, invalid: []string{"191910"}},
+		{method: "39", valid: []string{"200205", "10019400"}, invalid: []string{"200206"}},
+		{method: "40", valid: []string{"1258345", "3231963"}, invalid: []string{"1258346"}},
+		{method: "41", valid: []string{"4013410024", "4016660195", "0166805317", "4019310079", "4019340829", "4019151002"}, invalid: []string{"4013410025"}},
+		{method: "42", valid: []string{"59498", "59510"}, invalid: []string{"59499"}},
+		{method: "43", valid: []string{"6135244", "9516893476"}, invalid: []string{"6135245"}},
+		{method: "44", valid: []string{"889006", "2618040504"}, invalid: []string{"889007"}},
+		{method: "45", valid: []string{"3545343232", "4013410024", "0994681254", "0000012340", "1000199999", "0100114240"}, invalid: []string{"3545343233"}},
+		{method: "46", valid: []string{"0235468612", "0837890901", "1041447600"}, invalid: []string{"0235468712"}},
+		{method: "47", valid: []string{"1018000", "1003554450"}, invalid: []string{"1018010"}},
+		{method: "48", valid: []string{"1234567810", "3987654300"}, invalid: []string{"1234567820"}},
+		{method: "49", valid: []string{"9290701", "1234567899"}, invalid: []string{"9290702"}},
+		{method: "50", valid: []string{"4000005001", "4444442001"}, invalid: []string{"4000006001"}},
+		{method: "51", valid: []string{"0001156071", "0001156136", "0000156078", "0000156071", "0199100002", "0099100010", "2599100002", "0199100004", "2599100003", "3199204090"}, invalid: []string{"0099345678", "0099100110", "0199100040"}},
+		{method: "52", bankCode: "13051172", valid: []string{"43001500", "48726458"}, invalid: []string{"43001501"}},
+		{method: "53", bankCode: "16052072", valid: []string{"382432256"}, invalid: []string{"382432257"}},
+		{method: "54", valid: []string{"4964137395", "4900010987"}, invalid: []string{"4964137396", "5964137395"}},
+		{method: "55", valid: []string{"1234567895", "3987654327"}, invalid: []string{"1234567896"}},
+		{method: "56", valid: []string{"0290545005", "9718304037"}, invalid: []string{"0290545006"}},
+		{method: "57", valid: []string{"7500021766", "9400001734", "7800028282", "8100244186", "3251080371", "3891234567", "7777778800", "5001050352", "5045090090", "1909700805", "9322111030", "0185125434"}, invalid: []string{"7500021767", "0032013000", "1913700805"}},
+		{method: "58", valid: []string{"1800881120", "9200654108", "1015222224", "3703169668"}, invalid: []string{"1800881121"}},
+		{method: "59", valid: []string{"539290858", "9290702"}, invalid: []string{"539290859"}},
+		{method: "60", valid: []string{"1234567891", "3987654323"}, invalid: []string{"1234567892"}},
+		{method: "61", valid: []string{"2063099200", "0260760481"}, invalid: []string{"2063199200"}},
+		{method: "62", valid: []string{"5029076701"}, invalid: []string{"5029076801"}},
+		{method: "63", valid: []string{"123456600"}, invalid: []string{"123456700", "1123456600"}},
+		{method: "64", valid: []string{"1206473010", "5016511020"}, invalid: []string{"1206474010"}},
+		{method: "65", valid: []string{"1234567400", "1234567590"}, invalid: []string{"1234567500"}},
+		{method: "66", valid: []string{"100150502", "100154508", "101154508", "100154516", "101154516"}, invalid: []string{"100154509", "101154509", "100154519", "101154519"}},
+		{method: "67", valid: []string{"1234567490", "3987654520"}, invalid: []string{"1234567590"}},
+		{method: "68", valid: []string{"8889654328", "987654324", "400000000"}, invalid: []string{"8889654329", "1234567890"}},
+		{method: "69", valid: []string{"9721134869", "9300000000"}, invalid: []string{"9721134868"}},
+		{method: "70", valid: []string{"1234567892", "0005123402"}, invalid: []string{"0005123403"}},
+		{method: "71", valid: []string{"7101234007"}, invalid: []string{"7101234008"}},
+		{method: "72", valid: []string{"1234567897", "3987654320"}, invalid: []string{"1234567898"}},
+		{method: "73", valid: []string{"0003503398", "0001340967", "0003503391", "0001340968", "0003503392", "0001340966", "123456"}, invalid: []string{"121212", "987654321"}},
+		{method: "74", valid: []string{"1016", "26260", "242243", "242248", "18002113", "1821200043"}, invalid: []string{"1011", "26265", "18002118", "6160000024"}},
+		{method: "75", valid: []string{"0001234567", "0123455780"}, invalid: []string{"0001234568", "1234567891"}},
+		{method: "76", valid: []string{"0006543200", "9012345600", "7876543100", "6543200"}, invalid: []string{"0006543300", "2012345600"}},
+		{method: "77", valid: []string{"10338"}, invalid: []string{"10339"}},
+		{method: "78", valid: []string{"12345678", "9290701"}, invalid: []string{"9290702"}},
+		{method: "79", valid: []string{"3987654328", "1234567820"}, invalid: []string{"3987654329", "0123456782"}},
+		{method: "80", valid: []string{"340968", "340966"}, invalid: []string{"340967"}},
+		{method: "81", valid: []string{"0646440", "1359100", "0199100002"}, invalid: []string{"0646441"}},
+		{method: "82", valid: []string{"1299654320", "48658"}, invalid: []string{"1299654321"}},
+		{method: "83", valid: []string{"0001156071", "0001156136", "0000156078", "0000156071", "0099100002"}, invalid: []string{"0099100003"}},
+		{method: "84", valid: []string{"240699", "350982", "461059", "240692", "350985", "461052", "0199100002"}, invalid: []string{"240965", "350980", "461053"}},
+		{method: "85", valid: []string{"0001156071", "0001156136", "0000156078", "0000156071", "3199100002"}, invalid: []string{"3199100003"}},
+		{method: "86", valid: []string{"340968", "1001171", "1009588", "123897", "340960", "0199100002"}, invalid: []string{"0199100003"}},
+		{method: "87", valid: []string{"0000000406", "0000051768", "0010701590", "0010720185", "0000100005", "0000393814", "0000950360", "3199500501"}, invalid: []string{"0000000407"}},
+		{method: "88", valid: []string{"2525259", "1000500", "90013000", "92525253", "99913003"}, invalid: []string{"2525258"}},
+		{method: "89", valid: []string{"0001234561", "12345008", "1234567890"}, invalid: []string{"0001234562"}},
+		{method: "90", valid: []string{"0001975641", "0001988654", "0000863530", "0000784451", "0000997664", "0000996663", "0000666034"}, invalid: []string{"0000997669"}},
+		{method: "91", valid: []string{"2974118000", "5281741000", "9952810000", "2974117000", "5281770000", "9952812000", "8840019000", "8840050000", "8840087000", "8840045000", "8840012000", "8840055000", "8840080000"}, invalid: []string{"2974119000"}},
+		{method: "92", valid: []string{"1234567893", "3987654325"}, invalid: []string{"1234567894"}},
+		{method: "93", valid: []string{"6714790000", "0000671479", "1277830000", "0000127783", "1277910000", "0000127791", "3067540000", "0000306754"}, invalid: []string{"6714780000"}},
+		{method: "94", valid: []string{"6782533003"}, invalid: []string{"6782533004"}},
+		{method: "95", valid: []string{"0068007003", "0847321750", "6450060494", "6454000003"}, invalid: []string{"6454000004"}},
+		{method: "96", valid: []string{"0000254100", "9421000009", "0000000208", "0101115152", "0301204301"}, invalid: []string{"9421000008"}},
+		{method: "97", valid: []string{"24010019"}, invalid: []string{"24010018"}},
+		{method: "98", valid: []string{"9619439213", "3009800016", "9619509976", "5989800173", "9619319999", "6719430018"}, invalid: []string{"9619439214"}},
+		{method: "99", valid: []string{"0068007003", "0847321750", "0400000000"}, invalid: []string{"0068007004"}},
+		{method: "A0", valid: []string{"521003287", "54500", "3287", "18761", "28290"}, invalid: []string{"521003288"}},
+		{method: "A1", valid: []string{"0010030005", "0010030997", "1010030054"}, invalid: []string{"0110030005", "0010030998", "0000030005"}},
+		{method: "A2", valid: []string{"3456789019", "5678901231", "3456789012"}, invalid: []string{"3456789018"}},
+		{method: "A3", valid: []string{"1234567897", "0123456782", "9876543210", "1234567890"}, invalid: []string{"1234567891"}},
+		{method: "A4", valid: []string{"0004711173", "0007093330", "8623420004", "0001123458", "1199503010", "8499421235", "0000862342", "8997710000", "0664040000", "0000905844", "5030101099", "1299503117"}, invalid: []string{"0004711174"}},
+		{method: "A5", valid: []string{"9941510001", "9961230019", "9380027210", "9932290910", "0000251437", "0007948344", "0000159590", "0000051640"}, invalid: []string{"9941510002"}},
+		{method: "A6", valid: []string{"800048548", "0855000014", "17", "55300030", "150178033", "600003555", "900291823"}, invalid: []string{"800048549"}},
+		{method: "A7", valid: []string{"19010008", "19010438", "19010660", "19010876", "209010892"}, invalid: []string{"19010009"}},
+		{method: "A8", valid: []string{"7436661", "7436670", "1359100", "7436660", "7436678", "0003503398", "0001340967", "0199100002"}, invalid: []string{"7436669"}},
+		{method: "A9", valid: []string{"5043608", "86725", "504360", "822035", "32577083"}, invalid: []string{"5043609"}},
+		{method: "B0", valid: []string{"1197423162", "1000000606", "1000000406", "1035791538", "1126939724", "1197423460"}, invalid: []string{"0197423162", "8197423162", "1197423461"}},
+		{method: "B1", valid: []string{"1434253150", "2746315471", "7414398260", "8347251693"}, invalid: []string{"1434253151"}},
+		{method: "B2", valid: []string{"0020012357", "0080012345", "0926801910", "1002345674", "8000990054", "9000481805"}, invalid: []string{"0020012358"}},
+		{method: "B3", valid: []string{"1000000060"}, invalid: []string{"1000000061"}},
+		{method: "B4", valid: []string{"9941510001", "9961230019", "0000251437"}, invalid: []string{"9941510002"}},
+		{method: "B5", valid: []string{"0159006955", "2000123451", "1151043216", "9000939033", "0123456782", "0130098767", "1045000252"}, invalid: []string{"0159006957"}},
+		{method: "B6", bankCode: "80053762", valid: []string{"487310018"}, invalid: []string{"467310018", "477310018"}},
+		{method: "B7", valid: []string{"0700001529", "0730000019", "0001001008", "0001057887", "0001007222", "0810011825", "0800107653", "0005922372"}, invalid: []string{"0001057886", "0003815570", "0005620516", "0740912243", "0893524479"}},
+		{method: "B8", valid: []string{"0734192657", "6932875274", "3145863029", "2938692523", "5011654366", "9011200140"}, invalid: []string{"0734192658"}},
+		{method: "B9", valid: []string{"87920187", "41203755", "81069577", "61287958", "58467232", "7125633", "1253657", "4353631"}, invalid: []string{"0134211909", "0100041104", "0100054106", "0200025107", "0150013107"}},
+		{method: "C0", bankCode: "13051172", valid: []string{"43001500", "48726458", "0082335729", "0734192657", "6932875274"}, invalid: []string{"0734192658"}},
+		{method: "C1", valid: []string{"0446786040", "0478046940", "0701625830", "0701625840", "0882095630", "5432112349", "5543223456", "5654334563", "5765445670", "5876556788"}, invalid: []string{"0446786140", "5432112340"}},
+		{method: "C2", valid: []string{"2394871426", "4218461950", "7352569148", "5127485166", "8738142564"}, invalid: []string{"2394871427"}},
+		{method: "C3", valid: []string{"9294182", "4431276", "19919", "9000420530", "9000010006", "9000577650"}, invalid: []string{"9294183"}},
+		{method: "C4", valid: []string{"0000000019", "0000292932", "0000094455", "9000420530", "9000010006", "9000577650"}, invalid: []string{"0000000018"}},
+		{method: "C5", valid: []string{"0000301168", "0000302554", "0300020050", "0300566000", "1000061378", "1000061412", "4450164064", "4863476104", "5000000028", "5000000391", "6450008149", "6800001016", "9000100012", "9000210017", "3060188103", "3070402023", "7000000000"}, invalid: []string{"0000301169", "2000061378"}},
+		{method: "C6", valid: []string{"0000065516", "0203178249", "1031405209", "1082012201", "2003455189", "2004001016", "3110150986", "3068459207", "5035105948", "5286102149", "4012660028", "4100235626", "6028426119", "6861001755", "7008199027", "7002000023", "8526080015", "8711072264", "9000430223", "9000781153"}, invalid: []string{"0525111212", "0091423614", "1082311275", "1000118821", "2004306518", "2016001206", "3462816371", "3622548632", "4232300158", "4000456126", "5002684526", "5564123850", "6295473774", "6640806317", "7000062022", "7006003027", "8348300002", "8654216984", "9000641509", "9000260986"}},
+		{method: "C7", valid: []string{"3500022", "38150900", "600103660", "39101181", "94012341", "5073321010"}, invalid: []string{"3500023"}},
+		{method: "C8", valid: []string{"3456789019", "5678901231", "3456789012"}, invalid: []string{"3456789018"}},
+		{method: "C9", valid: []string{"3456789019", "5678901231"}, invalid: []string{"3456789018"}},
+		{method: "D0", valid: []string{"6100272324", "6100273479", "5700000000"}, invalid: []string{"6100272325"}},
+		{method: "D1", valid: []string{"0082012203", "1452683581", "2129642505", "3002000027", "4230001407", "5000065514", "6001526215", "7126502149", "9000430223"}, invalid: []string{"0000260986", "1062813622", "2256412314", "3012084101", "4006003027", "5814500990", "6128462594", "7000062035", "8003306026", "9000641509"}},
+		{method: "D2", valid: []string{"189912137", "235308215", "4455667784", "1234567897"}, invalid: []string{"4455667780"}},
+		{method: "D3", valid: []string{"1600169591", "1600189151", "1800084079", "6019937007", "6021354007", "6030642006"}, invalid: []string{"1600169592"}},
+		{method: "D4", valid: []string{"1234567898", "3987654329"}, invalid: []string{"1234567899", "0234567898"}},
+		{method: "D5", valid: []string{"5999718138", "1799222116", "0099632004"}, invalid: []string{"3299632008", "1999204293", "0399242139"}},
+		{method: "D6", valid: []string{"3409", "585327", "1650513"}, invalid: []string{"3408"}},
+		{method: "D7", valid: []string{"0500018205", "0230103715", "0301000434", "0330035104", "0420001202", "0134637709", "0201005939", "0602006999"}, invalid: []string{"0500018206"}},
+		{method: "D8", valid: []string{"1403414848", "6800000439", "6899999954", "0010000000", "0099999999"}, invalid: []string{"1403414849", "0009999999"}},
+		{method: "D9", valid: []string{"1234567897", "0123456782", "9876543210", "1234567890", "0123456789", "1100132044", "1100669030"}, invalid: []string{"1100132045"}},
+		{method: "E0", valid: []string{"1234568013", "1534568010", "2610015", "8741013011"}, invalid: []string{"1234769013", "2710014", "9741015011"}},
+		{method: "E1", valid: []string{"0134211909", "0100041104", "0100054106", "0200025107"}, invalid: []string{"0150013107", "0200035101", "0081313890", "4268550840", "0987402008"}},
+		{method: "E2", valid: []string{"0003831745", "0051330335"}, invalid: []string{"0003831746", "6003831745"}},
+		{method: "E3", valid: []string{"9290701", "539290858", "1501824"}, invalid: []string{"1501825"}},
+		{method: "E4", valid: []string{"1234567897", "9290701"}, invalid: []string{"9290703"}},
+	}
+)
+
+func loadDirectory(t *testing.T) *Directory {
+	dir, err := LoadDirectoryFile("testdata/blz.txt")
+	require.NoError(t, err)
+	return dir
+}
+
+func TestLoadDirectory(t *testing.T) {
+	dir := loadDirectory(t)
+	require.Equal(t, 2, dir.Len())
+
+	bank, ok := dir.Lookup("37040044")
+	require.True(t, ok)
+	require.Equal(t, "37040044", bank.Code)
+	require.Equal(t, "Commerzbank", bank.Name)
+	require.Equal(t, "COBADEFFXXX", bank.BIC)
+	require.Equal(t, Method("13"), bank.Method)
+
+	_, ok = dir.Lookup("99999999")
+	require.False(t, ok)
+}
+
+func TestLoadDirectoryInvalid(t *testing.T) {
+	_, err := LoadDirectory(strings.NewReader("370400441Commerzbank\n"))
+	require.Equal(t, ErrInvalidRecord, err)
+
+	_, err = LoadDirectoryFile("testdata/missing.txt")
+	require.Error(t, err)
+}
+
+func TestValidateAccount(t *testing.T) {
+	v := NewValidator(loadDirectory(t))
+	for _, cs := range accountCases {
+		t.Run(cs.bankCode+cs.account, func(t *testing.T) {
+			method, err := v.ValidateAccount(cs.bankCode, cs.account)
+			require.Equal(t, cs.method, method)
+			if cs.valid {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, ErrInvalidAccountNumber, err)
+			}
+		})
+	}
+}
+
+func TestValidateAccountUnknown(t *testing.T) {
+	v := NewValidator(loadDirectory(t))
+
+	_, err := v.ValidateAccount("99999999", "1234567890")
+	require.Equal(t, ErrBankCodeNotPresent, err)
+
+	dir, err := LoadDirectory(strings.NewReader("100100991" + strings.Repeat(" ", 141) + "12\n"))
+	require.NoError(t, err)
+
+	method, err := NewValidator(dir).ValidateAccount("10010099", "1234567890")
+	require.Equal(t, Method("12"), method)
+	require.Equal(t, ErrMethodNotSupported, err)
+}
+
+func TestMethods(t *testing.T) {
+	for _, cs := range methodCases {
+		t.Run(string(cs.method), func(t *testing.T) {
+			check, ok := methods[cs.method]
+			require.True(t, ok)
+
+			for _, number := range cs.valid {
+				a, ok := newAccount(number, cs.bankCode)
+				require.True(t, ok)
+				require.True(t, check(a), number)
+			}
+			for _, number := range cs.invalid {
+				a, ok := newAccount(number, cs.bankCode)
+				require.True(t, ok)
+				require.False(t, check(a), number)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	v := NewValidator(loadDirectory(t))
+
+	method, err := v.Validate(iban.MustParse("DE89370400440532013000"))
+	require.NoError(t, err)
+	require.Equal(t, Method("13"), method)
+
+	ibn, err := iban.FromBban("DE", "370400440532014000")
+	require.NoError(t, err)
+	_, err = v.Validate(ibn)
+	require.Equal(t, ErrInvalidAccountNumber, err)
+
+	_, err = v.Validate(iban.MustParse("GB29NWBK60161331926819"))
+	require.Equal(t, ErrNotGerman, err)
+}
+
+func TestSupported(t *testing.T) {
+	require.True(t, Supported("00"))
+	require.True(t, Supported("13"))
+	require.True(t, Supported("E4"))
+	require.False(t, Supported("12"))
+}
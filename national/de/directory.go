@@ -0,0 +1,98 @@
+package de
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// Error codes returned by failures to load bank directory.
+var (
+	ErrInvalidRecord = errors.New("de: invalid bank directory record")
+)
+
+const (
+	// minRecordLength represents minimal length of bank directory record.
+	minRecordLength = 152
+
+	// featurePaymentProvider marks the main record of a bank code.
+	featurePaymentProvider = '1'
+)
+
+// Bank holds info about a bank code loaded from bank directory.
+type Bank struct {
+	Code   string
+	Name   string
+	BIC    string
+	Method Method
+}
+
+// Directory holds banks loaded from Bundesbank bank directory
+// (Bankleitzahlendatei) keyed by bank code.
+type Directory struct {
+	banks map[string]Bank
+}
+
+// Lookup returns Bank by given bank code.
+func (d *Directory) Lookup(code string) (Bank, bool) {
+	bank, ok := d.banks[code]
+	return bank, ok
+}
+
+// Len returns number of bank codes in directory.
+func (d *Directory) Len() int {
+	return len(d.banks)
+}
+
+// LoadDirectory loads bank directory in the fixed length format published
+// by Bundesbank. Records are encoded in ISO 8859-1.
+func LoadDirectory(r io.Reader) (*Directory, error) {
+	dir := &Directory{banks: make(map[string]Bank)}
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(line) < minRecordLength {
+			return nil, ErrInvalidRecord
+		}
+
+		bank := Bank{
+			Code:   line[0:8],
+			Name:   strings.TrimSpace(decodeLatin1(line[9:67])),
+			BIC:    strings.TrimSpace(line[139:150]),
+			Method: Method(line[150:152]),
+		}
+		if _, ok := dir.banks[bank.Code]; ok && line[8] != featurePaymentProvider {
+			continue
+		}
+		dir.banks[bank.Code] = bank
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return dir, nil
+}
+
+// LoadDirectoryFile loads bank directory from given file.
+func LoadDirectoryFile(path string) (*Directory, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadDirectory(f)
+}
+
+func decodeLatin1(s string) string {
+	runes := make([]rune, len(s))
+	for idx := 0; idx < len(s); idx++ {
+		runes[idx] = rune(s[idx])
+	}
+	return string(runes)
+}
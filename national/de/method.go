@@ -0,0 +1,283 @@
+package de
+
+// Method represents Bundesbank check digit method (Prüfzifferberechnungsmethode)
+// identified by its two character code.
+type Method string
+
+const (
+	// accountLength represents length of zero padded german account number.
+	accountLength = 10
+
+	// checkPosition represents default position of check digit in account number.
+	checkPosition = 10
+
+	// invalid marks check digit which makes account number invalid.
+	invalid = -1
+)
+
+var (
+	weights21   = []int{2, 1, 2, 1, 2, 1, 2, 1, 2}
+	weights12   = []int{1, 2, 1, 2, 1, 2, 1, 2, 1}
+	weights371  = []int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+	weights731  = []int{7, 3, 1, 7, 3, 1, 7, 3, 1}
+	weights3971 = []int{3, 9, 7, 1, 3, 9, 7, 1, 3}
+	weights31   = []int{3, 1, 3, 1, 3, 1, 3, 1, 3}
+	weights317  = []int{3, 1, 7, 3, 1, 7, 3}
+	weights29   = []int{2, 3, 4, 5, 6, 7, 8, 9, 2}
+	weights27   = []int{2, 3, 4, 5, 6, 7, 2, 3, 4}
+	weights291  = []int{2, 3, 4, 5, 6, 7, 8, 9, 1}
+	weights293  = []int{2, 3, 4, 5, 6, 7, 8, 9, 3}
+	weights278  = []int{2, 3, 4, 5, 6, 7, 8, 7, 8}
+	weights210  = []int{2, 3, 4, 5, 6, 7, 8, 9, 10}
+	weights19   = []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	weights91   = []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	weights248  = []int{2, 4, 8, 5, 10, 9, 7, 3, 6, 1, 2, 4}
+)
+
+// transformRows holds rows of the iterated transformation table (M10H),
+// digit at position p of account number is transformed by row at index
+// p-1.
+var transformRows = [9][10]int{
+	{0, 1, 5, 9, 3, 7, 4, 8, 2, 6},
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{0, 1, 8, 4, 6, 2, 9, 5, 7, 3},
+	{0, 1, 7, 6, 9, 8, 3, 2, 5, 4},
+	{0, 1, 5, 9, 3, 7, 4, 8, 2, 6},
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{0, 1, 8, 4, 6, 2, 9, 5, 7, 3},
+	{0, 1, 7, 6, 9, 8, 3, 2, 5, 4},
+	{0, 1, 5, 9, 3, 7, 4, 8, 2, 6},
+}
+
+// account holds zero padded account number and bank code it belongs to.
+type account struct {
+	digits [accountLength]int
+	bank   string
+}
+
+// at returns digit at given position, positions are counted from 1 as in
+// the Bundesbank specification.
+func (a account) at(pos int) int {
+	return a.digits[pos-1]
+}
+
+// number returns value of digits at positions from to to.
+func (a account) number(from int, to int) int {
+	var n int
+	for pos := from; pos <= to; pos++ {
+		n = n*10 + a.at(pos)
+	}
+	return n
+}
+
+// length returns number of digits without leading zeros.
+func (a account) length() int {
+	for pos := 1; pos <= accountLength; pos++ {
+		if a.at(pos) != 0 {
+			return accountLength - pos + 1
+		}
+	}
+	return 0
+}
+
+// zero returns true if digits at positions from to to are zeros.
+func (a account) zero(from int, to int) bool {
+	return a.number(from, to) == 0
+}
+
+// shift returns account number shifted left by n positions, it is used
+// when trailing sub account number is left out.
+func (a account) shift(n int) account {
+	var shifted account
+	copy(shifted.digits[:], a.digits[n:])
+	shifted.bank = a.bank
+	return shifted
+}
+
+// sum returns sum of digits at positions from to to multiplied by weights,
+// weights are applied from right to left.
+func (a account) sum(from int, to int, weights []int) int {
+	var s int
+	for idx, pos := 0, to; pos >= from; idx, pos = idx+1, pos-1 {
+		s += a.at(pos) * weights[idx]
+	}
+	return s
+}
+
+// crossSum returns sum of cross sums of digits at positions from to to
+// multiplied by weights, weights are applied from right to left.
+func (a account) crossSum(from int, to int, weights []int) int {
+	var s int
+	for idx, pos := 0, to; pos >= from; idx, pos = idx+1, pos-1 {
+		s += digitSum(a.at(pos) * weights[idx])
+	}
+	return s
+}
+
+// check returns true if digit at given position equals check digit.
+func (a account) check(pos int, digit int) bool {
+	return digit != invalid && a.at(pos) == digit
+}
+
+// checkLast returns true if last digit equals check digit.
+func (a account) checkLast(digit int) bool {
+	return a.check(checkPosition, digit)
+}
+
+// mod10 returns difference of sum to the next multiple of ten.
+func mod10(sum int) int {
+	return (10 - sum%10) % 10
+}
+
+// mod11 returns difference of remainder to 11, remainder 0 gives check digit
+// 0 and remainder 1 makes account number invalid.
+func mod11(sum int) int {
+	return mod11Rem1(sum, invalid)
+}
+
+// mod11Zero returns difference of remainder to 11, remainders 0 and 1 give
+// check digit 0.
+func mod11Zero(sum int) int {
+	return mod11Rem1(sum, 0)
+}
+
+// mod11Rem1 returns difference of remainder to 11, remainder 0 gives check
+// digit 0 and remainder 1 gives given check digit.
+func mod11Rem1(sum int, rem1 int) int {
+	switch rem := sum % 11; rem {
+	case 0:
+		return 0
+	case 1:
+		return rem1
+	default:
+		return 11 - rem
+	}
+}
+
+// mod11Minus1 returns difference of remainder of sum reduced by one to 10,
+// remainder 0 gives check digit 0.
+func mod11Minus1(sum int) int {
+	rem := (sum - 1) % 11
+	if rem == 0 {
+		return 0
+	}
+	return 10 - rem
+}
+
+// mod7 returns difference of remainder to 7, remainder 0 gives check digit 0.
+func mod7(sum int) int {
+	return (7 - sum%7) % 7
+}
+
+// mod9 returns difference of remainder to 9, remainder 0 gives check digit 0.
+func mod9(sum int) int {
+	return (9 - sum%9) % 9
+}
+
+func digitSum(n int) int {
+	var s int
+	for ; n > 0; n /= 10 {
+		s += n % 10
+	}
+	return s
+}
+
+// transformSum returns sum of digits at positions 1 to 9 transformed using
+// the iterated transformation table.
+func (a account) transformSum() int {
+	var s int
+	for pos := 1; pos <= 9; pos++ {
+		s += transformRows[pos-1][a.at(pos)]
+	}
+	return s
+}
+
+// prefixed applies method 00 to account number positions from to 9 prefixed
+// with given constant, check digit is at position 10.
+func (a account) prefixed(constant string, from int) bool {
+	digits := make([]int, 0, len(constant)+accountLength)
+	for _, c := range constant {
+		digits = append(digits, int(c-'0'))
+	}
+	for pos := from; pos < checkPosition; pos++ {
+		digits = append(digits, a.at(pos))
+	}
+
+	var s int
+	for idx := range digits {
+		s += digitSum(digits[len(digits)-1-idx] * weights21[idx%2])
+	}
+	return a.checkLast(mod10(s))
+}
+
+// eser validates eight or nine digit account numbers of the former ESER
+// system. The ESER account number is built from bank code digits, account
+// identifier, check digit and account number without leading zeros. Check
+// digit is chosen so that the weighted sum modulo 11 gives remainder 10.
+func eser(digits []int, check int) bool {
+	var s, weight int
+	for idx := range digits {
+		pos := len(digits) - 1 - idx
+		if pos == check {
+			weight = weights248[idx]
+			continue
+		}
+		s += digits[pos] * weights248[idx]
+	}
+	for digit := 0; digit <= 9; digit++ {
+		if (s+digit*weight)%11 == 10 {
+			return digit == digits[check]
+		}
+	}
+	return false
+}
+
+// eserDigits returns digits of bank code followed by given digits and by
+// digits of account number from given position without leading zeros.
+func (a account) eserDigits(bank string, from int, digits ...int) []int {
+	result := make([]int, 0, 12)
+	for _, c := range bank {
+		result = append(result, int(c-'0'))
+	}
+	result = append(result, digits...)
+	pos := from
+	for pos < accountLength && a.at(pos) == 0 {
+		pos++
+	}
+	for ; pos <= accountLength; pos++ {
+		result = append(result, a.at(pos))
+	}
+	return result
+}
+
+// validBankCode returns true if bank code consists of eight digits.
+func (a account) validBankCode() bool {
+	if len(a.bank) != 8 {
+		return false
+	}
+	for _, c := range a.bank {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// newAccount creates account from account number and bank code, account
+// number is left padded with zeros.
+func newAccount(number string, bank string) (account, bool) {
+	var a account
+	if number == "" || len(number) > accountLength {
+		return a, false
+	}
+
+	offset := accountLength - len(number)
+	for idx, c := range number {
+		if c < '0' || c > '9' {
+			return a, false
+		}
+		a.digits[offset+idx] = int(c - '0')
+	}
+	a.bank = bank
+	return a, true
+}
@@ -0,0 +1,244 @@
+// Package gb provides UK modulus checking of sorting code and account
+// number pairs as specified by Vocalink, driven by the modulus weight table
+// (valacdos) and sorting code substitution table (scsubtab).
+package gb
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jbub/banking/iban"
+)
+
+// countryCode represents country code of UK iban.
+const countryCode = "GB"
+
+// Error codes returned by failures to validate an account number.
+var (
+	ErrNotBritish           = errors.New("gb: iban is not british")
+	ErrInvalidSortCode      = errors.New("gb: invalid sorting code")
+	ErrInvalidAccountNumber = errors.New("gb: invalid account number")
+)
+
+const (
+	// accountLength represents length of account number.
+	accountLength = 8
+
+	// minAccountLength represents minimal length of account number padded with zeros.
+	minAccountLength = 6
+
+	// Positions of digits in sorting code and account number.
+	posA = 6
+	posB = 7
+	posC = 8
+	posG = 12
+	posH = 13
+
+	// exception1Total is added to total of rules with exception 1.
+	exception1Total = 27
+
+	// exception8SortCode replaces sorting code of rules with exception 8.
+	exception8SortCode = "090126"
+
+	// exception9SortCode replaces sorting code of second rule with exception 9.
+	exception9SortCode = "309634"
+)
+
+var (
+	// exception2Weights replace weights of rules with exception 2 if g is not 9.
+	exception2Weights = [weightsLength]int{0, 0, 1, 2, 5, 3, 6, 4, 8, 7, 10, 9, 3, 1}
+
+	// exception2Weights9 replace weights of rules with exception 2 if g is 9.
+	exception2Weights9 = [weightsLength]int{0, 0, 0, 0, 0, 0, 0, 0, 8, 7, 10, 9, 3, 1}
+)
+
+// Validator validates UK sorting code and account number pairs.
+type Validator struct {
+	table *Table
+}
+
+// NewValidator creates a new Validator using given modulus tables.
+func NewValidator(table *Table) *Validator {
+	return &Validator{table: table}
+}
+
+// Validate validates sorting code and account number of UK iban.
+func (v *Validator) Validate(ibn *iban.Iban) error {
+	if ibn.CountryCode() != countryCode {
+		return ErrNotBritish
+	}
+	return v.ValidateAccount(ibn.BranchCode(), ibn.AccountNumber())
+}
+
+// ValidateAccount validates sorting code and account number. Account
+// numbers shorter than eight digits are padded with leading zeros.
+// Sorting codes not present in modulus weight table can not be checked
+// and are considered valid.
+func (v *Validator) ValidateAccount(sortCode string, account string) error {
+	sortCode = strings.ReplaceAll(sortCode, "-", "")
+	if !isSortCode(sortCode) {
+		return ErrInvalidSortCode
+	}
+	if len(account) < minAccountLength || len(account) > accountLength {
+		return ErrInvalidAccountNumber
+	}
+	account = strings.Repeat("0", accountLength-len(account)) + account
+	if !isNumeric(account) {
+		return ErrInvalidAccountNumber
+	}
+
+	if !v.valid(sortCode, account) {
+		return ErrInvalidAccountNumber
+	}
+	return nil
+}
+
+func (v *Validator) valid(sortCode string, account string) bool {
+	rules := v.table.lookup(sortCode)
+	if len(rules) == 0 {
+		return true
+	}
+
+	digits := toDigits(sortCode + account)
+	first := rules[0]
+	if first.exception == 6 && isForeignCurrency(digits) {
+		return true
+	}
+
+	firstValid := v.check(first, sortCode, account)
+	if len(rules) == 1 {
+		if !firstValid && first.exception == 14 {
+			return checkException14(first, sortCode, account)
+		}
+		return firstValid
+	}
+
+	second := rules[1]
+	switch {
+	case first.exception == 2 && second.exception == 9:
+		return firstValid || v.check(second, exception9SortCode, account)
+	case first.exception == 10 && second.exception == 11,
+		first.exception == 12 && second.exception == 13:
+		return firstValid || v.check(second, sortCode, account)
+	case !firstValid:
+		return false
+	case second.exception == 3 && (digits[posC] == 6 || digits[posC] == 9):
+		return true
+	}
+	return v.check(second, sortCode, account)
+}
+
+func (v *Validator) check(r rule, sortCode string, account string) bool {
+	switch r.exception {
+	case 5:
+		if sub, ok := v.table.subs[sortCode]; ok {
+			sortCode = sub
+		}
+	case 8:
+		sortCode = exception8SortCode
+	}
+
+	digits := toDigits(sortCode + account)
+	weights := r.weights
+	switch r.exception {
+	case 2:
+		if digits[posA] != 0 {
+			weights = exception2Weights
+			if digits[posG] == 9 {
+				weights = exception2Weights9
+			}
+		}
+	case 7:
+		if digits[posG] == 9 {
+			zeroiseUB(&weights)
+		}
+	case 10:
+		if ab := digits[posA]*10 + digits[posB]; (ab == 9 || ab == 99) && digits[posG] == 9 {
+			zeroiseUB(&weights)
+		}
+	}
+
+	total := weightedTotal(digits, weights, r.method == DBLAL)
+	if r.exception == 1 {
+		total += exception1Total
+	}
+
+	switch r.method {
+	case MOD11:
+		switch r.exception {
+		case 4:
+			return total%11 == digits[posG]*10+digits[posH]
+		case 5:
+			switch rem := total % 11; rem {
+			case 0:
+				return digits[posG] == 0
+			case 1:
+				return false
+			default:
+				return 11-rem == digits[posG]
+			}
+		}
+		return total%11 == 0
+	default:
+		if r.exception == 5 {
+			if rem := total % 10; rem != 0 {
+				return 10-rem == digits[posH]
+			}
+			return digits[posH] == 0
+		}
+		return total%10 == 0
+	}
+}
+
+// checkException14 retries failed check with the last digit of account
+// number removed and account number shifted right when the last digit
+// is 0, 1 or 9.
+func checkException14(r rule, sortCode string, account string) bool {
+	switch account[accountLength-1] {
+	case '0', '1', '9':
+	default:
+		return false
+	}
+
+	digits := toDigits(sortCode + "0" + account[:accountLength-1])
+	return weightedTotal(digits, r.weights, false)%11 == 0
+}
+
+func weightedTotal(digits []int, weights [weightsLength]int, crossSum bool) int {
+	var total int
+	for idx, w := range weights {
+		p := digits[idx] * w
+		if crossSum {
+			p = p/10 + p%10
+		}
+		total += p
+	}
+	return total
+}
+
+func zeroiseUB(weights *[weightsLength]int) {
+	for idx := 0; idx <= posB; idx++ {
+		weights[idx] = 0
+	}
+}
+
+func isForeignCurrency(digits []int) bool {
+	return digits[posA] >= 4 && digits[posA] <= 8 && digits[posG] == digits[posH]
+}
+
+func isNumeric(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func toDigits(value string) []int {
+	digits := make([]int, len(value))
+	for idx, c := range value {
+		digits[idx] = int(c - '0')
+	}
+	return digits
+}
@@ -0,0 +1,127 @@
+package gb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/iban"
+)
+
+var (
+	accountCases = []struct {
+		name     string
+		sortCode string
+		account  string
+		valid    bool
+	}{
+		{"mod10", "089999", "66374958", true},
+		{"mod10", "089999", "66374959", false},
+		{"mod11", "107999", "88837491", true},
+		{"mod11", "107999", "88837492", false},
+		{"mod11 dashes", "10-79-99", "88837491", true},
+		{"mod11 short", "107999", "888374", false},
+		{"both", "200050", "67409318", true},
+		{"both second fails", "200050", "99388685", false},
+		{"exception 1", "200150", "68786576", true},
+		{"exception 1 without 27", "200150", "57188922", false},
+		{"exception 3 skipped", "200250", "83612653", true},
+		{"exception 3 applied", "200250", "26898233", false},
+		{"exception 4", "200350", "67899202", true},
+		{"exception 4 remainder", "200350", "67899203", false},
+		{"exception 6", "200450", "45672311", true},
+		{"exception 7 zeroised", "200550", "14946796", true},
+		{"exception 7", "200550", "72868575", true},
+		{"exception 7 invalid", "200550", "72868576", false},
+		{"exception 8", "200650", "07590196", true},
+		{"exception 8 invalid", "200650", "07590197", false},
+		{"exception 2 and 9 first", "200750", "30312628", true},
+		{"exception 2 and 9 second", "200750", "71395107", true},
+		{"exception 2 and 9 invalid", "200750", "71395108", false},
+		{"exception 10 and 11 first", "200850", "12371637", true},
+		{"exception 10 and 11 second", "200850", "48491388", true},
+		{"exception 10 zeroised", "200850", "09305790", true},
+		{"exception 10 and 11 invalid", "200850", "48491389", false},
+		{"exception 12 and 13 second", "200950", "73044910", true},
+		{"exception 14 shifted", "201050", "13163110", true},
+		{"exception 14 invalid digit", "201050", "81605023", false},
+		{"exception 5 substituted", "201150", "16389886", true},
+		{"exception 5", "201160", "92830089", true},
+		{"exception 5 zero check digit", "201160", "83208801", true},
+		{"exception 5 invalid", "201160", "92830088", false},
+		{"unknown sort code", "999999", "12345678", true},
+	}
+)
+
+func loadTable(t *testing.T) *Table {
+	table, err := LoadTableFiles("testdata/valacdos.txt", "testdata/scsubtab.txt")
+	require.NoError(t, err)
+	return table
+}
+
+func TestLoadTable(t *testing.T) {
+	table := loadTable(t)
+	require.Len(t, table.rules, 22)
+	require.Equal(t, map[string]string{"201150": "201199"}, table.subs)
+
+	rules := table.lookup("200050")
+	require.Len(t, rules, 2)
+	require.Equal(t, MOD11, rules[0].method)
+	require.Equal(t, DBLAL, rules[1].method)
+	require.Empty(t, table.lookup("999999"))
+}
+
+func TestLoadTableInvalid(t *testing.T) {
+	_, err := LoadTable(strings.NewReader("089999 MOD10 0 0 0"), nil)
+	require.Equal(t, ErrInvalidRule, err)
+
+	_, err = LoadTable(strings.NewReader("080211 089999 MOD12 0 0 0 0 0 0 7 1 3 7 1 3 7 1"), nil)
+	require.Equal(t, ErrInvalidRule, err)
+
+	_, err = LoadTable(strings.NewReader("080211 089999 MOD10 0 0 0 0 0 0 7 1 3 7 1 3 7 X"), nil)
+	require.Equal(t, ErrInvalidRule, err)
+
+	_, err = LoadTable(strings.NewReader(""), strings.NewReader("201150"))
+	require.Equal(t, ErrInvalidSubstitution, err)
+
+	_, err = LoadTableFiles("testdata/missing.txt", "")
+	require.Error(t, err)
+}
+
+func TestValidateAccount(t *testing.T) {
+	v := NewValidator(loadTable(t))
+	for _, cs := range accountCases {
+		t.Run(cs.name, func(t *testing.T) {
+			err := v.ValidateAccount(cs.sortCode, cs.account)
+			if cs.valid {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, ErrInvalidAccountNumber, err)
+			}
+		})
+	}
+}
+
+func TestValidateAccountInvalid(t *testing.T) {
+	v := NewValidator(loadTable(t))
+	require.Equal(t, ErrInvalidSortCode, v.ValidateAccount("10799", "88837491"))
+	require.Equal(t, ErrInvalidSortCode, v.ValidateAccount("1079A9", "88837491"))
+	require.Equal(t, ErrInvalidAccountNumber, v.ValidateAccount("107999", "888374911"))
+	require.Equal(t, ErrInvalidAccountNumber, v.ValidateAccount("107999", "8883749A"))
+	require.Equal(t, ErrInvalidAccountNumber, v.ValidateAccount("107999", "88374"))
+}
+
+func TestValidate(t *testing.T) {
+	v := NewValidator(loadTable(t))
+
+	ibn, err := iban.FromBban("GB", "NWBK10799988837491")
+	require.NoError(t, err)
+	require.NoError(t, v.Validate(ibn))
+
+	ibn, err = iban.FromBban("GB", "NWBK10799988837492")
+	require.NoError(t, err)
+	require.Equal(t, ErrInvalidAccountNumber, v.Validate(ibn))
+
+	require.Equal(t, ErrNotBritish, v.Validate(iban.MustParse("BE68539007547034")))
+}
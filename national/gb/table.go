@@ -0,0 +1,182 @@
+package gb
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Error codes returned by failures to load modulus tables.
+var (
+	ErrInvalidRule         = errors.New("gb: invalid modulus weight table record")
+	ErrInvalidSubstitution = errors.New("gb: invalid sorting code substitution record")
+)
+
+// Method represents modulus checking method.
+type Method string
+
+// Modulus checking methods.
+const (
+	MOD10 Method = "MOD10"
+	MOD11 Method = "MOD11"
+	DBLAL Method = "DBLAL"
+)
+
+const (
+	// weightsLength represents number of weights in a rule, six for sorting
+	// code digits u to z and eight for account number digits a to h.
+	weightsLength = 14
+
+	// sortCodeLength represents length of sorting code.
+	sortCodeLength = 6
+)
+
+// rule represents a single record of modulus weight table.
+type rule struct {
+	start     string
+	end       string
+	method    Method
+	weights   [weightsLength]int
+	exception int
+}
+
+// Table holds modulus weight table (valacdos) and sorting code
+// substitution table (scsubtab) published by Vocalink.
+type Table struct {
+	rules []rule
+	subs  map[string]string
+}
+
+// LoadTable loads modulus weight table and sorting code substitution table.
+// Substitution table is optional and can be nil.
+func LoadTable(valacdos io.Reader, scsubtab io.Reader) (*Table, error) {
+	rules, err := loadRules(valacdos)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make(map[string]string)
+	if scsubtab != nil {
+		if subs, err = loadSubstitutions(scsubtab); err != nil {
+			return nil, err
+		}
+	}
+	return &Table{rules: rules, subs: subs}, nil
+}
+
+// LoadTableFiles loads modulus weight table and sorting code substitution
+// table from given files. Substitution table path can be empty.
+func LoadTableFiles(valacdosPath string, scsubtabPath string) (*Table, error) {
+	valacdos, err := os.Open(valacdosPath)
+	if err != nil {
+		return nil, err
+	}
+	defer valacdos.Close()
+
+	if scsubtabPath == "" {
+		return LoadTable(valacdos, nil)
+	}
+
+	scsubtab, err := os.Open(scsubtabPath)
+	if err != nil {
+		return nil, err
+	}
+	defer scsubtab.Close()
+
+	return LoadTable(valacdos, scsubtab)
+}
+
+// lookup returns rules matching given sorting code in table order.
+func (t *Table) lookup(sortCode string) []rule {
+	var rules []rule
+	for _, r := range t.rules {
+		if r.start <= sortCode && sortCode <= r.end {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+func loadRules(r io.Reader) ([]rule, error) {
+	var rules []rule
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 3+weightsLength || len(fields) > 4+weightsLength {
+			return nil, ErrInvalidRule
+		}
+
+		ru := rule{
+			start:  fields[0],
+			end:    fields[1],
+			method: Method(fields[2]),
+		}
+		if !isSortCode(ru.start) || !isSortCode(ru.end) {
+			return nil, ErrInvalidRule
+		}
+		switch ru.method {
+		case MOD10, MOD11, DBLAL:
+		default:
+			return nil, ErrInvalidRule
+		}
+
+		for idx := range ru.weights {
+			w, err := strconv.Atoi(fields[3+idx])
+			if err != nil {
+				return nil, ErrInvalidRule
+			}
+			ru.weights[idx] = w
+		}
+		if len(fields) > 3+weightsLength {
+			exc, err := strconv.Atoi(fields[3+weightsLength])
+			if err != nil {
+				return nil, ErrInvalidRule
+			}
+			ru.exception = exc
+		}
+		rules = append(rules, ru)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func loadSubstitutions(r io.Reader) (map[string]string, error) {
+	subs := make(map[string]string)
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 || !isSortCode(fields[0]) || !isSortCode(fields[1]) {
+			return nil, ErrInvalidSubstitution
+		}
+		subs[fields[0]] = fields[1]
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+func isSortCode(value string) bool {
+	if len(value) != sortCodeLength {
+		return false
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
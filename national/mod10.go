@@ -0,0 +1,69 @@
+package national
+
+import (
+	"github.com/jbub/banking/bban"
+)
+
+// mod10 represents modulo used in weighted modulo 10 checks.
+const mod10 = 10
+
+var (
+	// polandWeights holds weights of Polish bank sort code.
+	polandWeights = []int{3, 9, 7, 1, 3, 9, 7}
+
+	// hungaryBankWeights holds weights of Hungarian bank and branch code.
+	hungaryBankWeights = []int{9, 7, 3, 1, 9, 7, 3, 1}
+
+	// hungaryAccountWeights holds weights of Hungarian account number.
+	hungaryAccountWeights = []int{9, 7, 3, 1, 9, 7, 3, 1, 9, 7, 3, 1, 9, 7, 3, 1}
+)
+
+// CheckPoland validates Polish bban, national check digit is calculated
+// from bank and branch code forming the eight digit sort code.
+func CheckPoland(bbn string, struc bban.Structure) bool {
+	mod := weightedMod(struc.Extract(bbn, bban.BankCode)+struc.Extract(bbn, bban.BranchCode), polandWeights, mod10)
+	if mod < 0 {
+		return false
+	}
+	return padDigits((mod10-mod)%mod10, 1) == struc.Extract(bbn, bban.NationalCheckDigit)
+}
+
+// CheckHungary validates Hungarian bban, it consists of two groups, the
+// first eight digits cover bank and branch code followed by their check
+// digit, the remaining sixteen digits cover account number followed by its
+// check digit. Weighted sum of each group must be divisible by 10.
+func CheckHungary(bbn string, _ bban.Structure) bool {
+	first := len(hungaryBankWeights)
+	if len(bbn) != first+len(hungaryAccountWeights) {
+		return false
+	}
+	return weightedMod(bbn[:first], hungaryBankWeights, mod10) == 0 &&
+		weightedMod(bbn[first:], hungaryAccountWeights, mod10) == 0
+}
+
+// CheckCroatia validates Croatian bban, both bank code and account number
+// end with check digit calculated using ISO 7064 MOD 11,10.
+func CheckCroatia(bbn string, struc bban.Structure) bool {
+	return checkMod1110(struc.Extract(bbn, bban.BankCode)) &&
+		checkMod1110(struc.Extract(bbn, bban.AccountNumber))
+}
+
+// checkMod1110 validates numeric value ending with ISO 7064 MOD 11,10 check digit.
+func checkMod1110(value string) bool {
+	if len(value) < 2 {
+		return false
+	}
+
+	product, sum := mod10, 0
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+		sum = (product + int(c-'0')) % mod10
+		if sum == 0 {
+			sum = mod10
+		}
+		product = (2 * sum) % (mod10 + 1)
+	}
+	return sum == 1
+}
@@ -0,0 +1,42 @@
+package national
+
+import (
+	"strconv"
+
+	"github.com/jbub/banking/bban"
+)
+
+// CheckMod9710 validates bban using ISO 7064 MOD 97-10, the whole bban
+// including trailing national check digits must give remainder 1.
+// It is used by Montenegro, Serbia, North Macedonia, Bosnia and Herzegovina,
+// Slovenia, East Timor and Portugal.
+func CheckMod9710(bbn string, _ bban.Structure) bool {
+	mod, ok := Mod97(bbn)
+	return ok && mod == 1
+}
+
+// CheckBelgium validates Belgian bban, national check digits equal to bank
+// code and account number modulo 97, where remainder 0 is replaced by 97.
+func CheckBelgium(bbn string, struc bban.Structure) bool {
+	digit := struc.Extract(bbn, bban.NationalCheckDigit)
+	if digit == "" || len(bbn) < len(digit) {
+		return false
+	}
+
+	mod, ok := Mod97(bbn[:len(bbn)-len(digit)])
+	if !ok {
+		return false
+	}
+	if mod == 0 {
+		mod = mod97
+	}
+	return padDigits(mod, len(digit)) == digit
+}
+
+func padDigits(value int, length int) string {
+	s := strconv.Itoa(value)
+	for len(s) < length {
+		s = "0" + s
+	}
+	return s
+}
@@ -0,0 +1,88 @@
+// Package national provides validation of national check digits embedded
+// in bban of various countries.
+package national
+
+import (
+	"errors"
+
+	"github.com/jbub/banking/bban"
+)
+
+// ErrInvalidValue is returned when check digits can not be calculated
+// from given value.
+var ErrInvalidValue = errors.New("national: invalid value")
+
+// checkers holds national check digit validators keyed by their names.
+var checkers = map[string]bban.Checker{
+	"CheckBelgium":       CheckBelgium,
+	"CheckCroatia":       CheckCroatia,
+	"CheckCzechSlovakia": CheckCzechSlovakia,
+	"CheckEstonia":       CheckEstonia,
+	"CheckFinland":       CheckFinland,
+	"CheckFrance":        CheckFrance,
+	"CheckHungary":       CheckHungary,
+	"CheckIceland":       CheckIceland,
+	"CheckItaly":         CheckItaly,
+	"CheckMod9710":       CheckMod9710,
+	"CheckNorway":        CheckNorway,
+	"CheckPoland":        CheckPoland,
+	"CheckSpain":         CheckSpain,
+}
+
+// LookupChecker returns national check digit validator by its function
+// name, e.g. CheckBelgium.
+func LookupChecker(name string) (bban.Checker, bool) {
+	checker, ok := checkers[name]
+	return checker, ok
+}
+
+const (
+	// mod97 represents value used in mod 97 checks.
+	mod97 = 97
+
+	// mod97Max is the maximum value allowed before reducing mod 97 total.
+	mod97Max = 999999999
+)
+
+// Mod97 calculates value of given alphanumeric string modulo 97 as in
+// ISO 7064 MOD 97-10, letters of any case are converted to numbers 10 to 35.
+// Returns false if value contains characters other than digits and letters.
+func Mod97(value string) (int, bool) {
+	var total int64
+	for _, c := range value {
+		switch {
+		case '0' <= c && c <= '9':
+			total = total*10 + int64(c-'0')
+		case 'A' <= c && c <= 'Z':
+			total = total*100 + int64(c-'A'+10)
+		case 'a' <= c && c <= 'z':
+			total = total*100 + int64(c-'a'+10)
+		default:
+			return 0, false
+		}
+
+		if total > mod97Max {
+			total %= mod97
+		}
+	}
+	return int(total % mod97), true
+}
+
+// weightedMod calculates weighted sum of digits modulo mod, weights are
+// aligned to the right end of value. Returns -1 if value is not numeric
+// or is longer than weights.
+func weightedMod(value string, weights []int, mod int) int {
+	offset := len(weights) - len(value)
+	if value == "" || offset < 0 {
+		return -1
+	}
+
+	var sum int
+	for idx, c := range value {
+		if c < '0' || c > '9' {
+			return -1
+		}
+		sum += int(c-'0') * weights[offset+idx]
+	}
+	return sum % mod
+}
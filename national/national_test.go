@@ -0,0 +1,262 @@
+package national
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/bban"
+)
+
+var (
+	checkerCases = []struct {
+		name    string
+		checker bban.Checker
+		struc   bban.Structure
+		bban    string
+		want    bool
+	}{
+		{"BE", CheckBelgium, belgium, "539007547034", true},
+		{"BE", CheckBelgium, belgium, "539007547035", false},
+		{"BE", CheckBelgium, belgium, "000000009797", true},
+		{"BE", CheckBelgium, belgium, "00000000979", false},
+		{"ME", CheckMod9710, bban.Structure{}, "505000012345678951", true},
+		{"ME", CheckMod9710, bban.Structure{}, "505000012345678952", false},
+		{"RS", CheckMod9710, bban.Structure{}, "260005601001611379", true},
+		{"RS", CheckMod9710, bban.Structure{}, "260005601001611397", false},
+		{"MK", CheckMod9710, bban.Structure{}, "250120000058984", true},
+		{"MK", CheckMod9710, bban.Structure{}, "250120000058948", false},
+		{"BA", CheckMod9710, bban.Structure{}, "1290079401028494", true},
+		{"BA", CheckMod9710, bban.Structure{}, "1290079401028449", false},
+		{"SI", CheckMod9710, bban.Structure{}, "263300012039086", true},
+		{"SI", CheckMod9710, bban.Structure{}, "263300012039068", false},
+		{"TL", CheckMod9710, bban.Structure{}, "0080012345678910157", true},
+		{"TL", CheckMod9710, bban.Structure{}, "0080012345678910175", false},
+		{"PT", CheckMod9710, bban.Structure{}, "000201231234567890154", true},
+		{"PT", CheckMod9710, bban.Structure{}, "000201231234567890145", false},
+		{"XX", CheckMod9710, bban.Structure{}, "12-4", false},
+		{"FR", CheckFrance, france, "20041010050500013M02606", true},
+		{"FR", CheckFrance, france, "20041010050500013N02606", false},
+		{"FR", CheckFrance, france, "30006000011234567890189", true},
+		{"FR", CheckFrance, france, "30006000011234567890198", false},
+		{"MC", CheckFrance, france, "11222000010123456789030", true},
+		{"MC", CheckFrance, france, "11222000010123456798030", false},
+		{"IT", CheckItaly, italy, "X0542811101000000123456", true},
+		{"IT", CheckItaly, italy, "X0542811101000000123465", false},
+		{"IT", CheckItaly, italy, "A0542811101000000123456", false},
+		{"SM", CheckItaly, italy, "U0322509800000000270100", true},
+		{"SM", CheckItaly, italy, "U0322509800000000271100", false},
+		{"ES", CheckSpain, spain, "21000418450200051332", true},
+		{"ES", CheckSpain, spain, "21000418540200051332", false},
+		{"ES", CheckSpain, spain, "21000418450200051323", false},
+		{"SK", CheckCzechSlovakia, czechSlovakia, "11000000002920884960", true},
+		{"SK", CheckCzechSlovakia, czechSlovakia, "11000000002920884969", false},
+		{"CZ", CheckCzechSlovakia, czechSlovakia, "08000000192000145399", true},
+		{"CZ", CheckCzechSlovakia, czechSlovakia, "08000000292000145399", false},
+		{"CZ", CheckCzechSlovakia, czechSlovakia, "0800000019200014539", false},
+		{"PL", CheckPoland, poland, "102010260000042270201111", true},
+		{"PL", CheckPoland, poland, "102010270000042270201111", false},
+		{"PL", CheckPoland, poland, "109010140000071219812874", true},
+		{"HU", CheckHungary, bban.Structure{}, "117730161111101800000000", true},
+		{"HU", CheckHungary, bban.Structure{}, "117730171111101800000000", false},
+		{"HU", CheckHungary, bban.Structure{}, "117730161111101900000000", false},
+		{"HU", CheckHungary, bban.Structure{}, "11773016111110180000000", false},
+		{"HR", CheckCroatia, croatia, "10010051863000160", true},
+		{"HR", CheckCroatia, croatia, "10010061863000160", false},
+		{"HR", CheckCroatia, croatia, "10010051863000161", false},
+		{"NO", CheckNorway, norway, "86011117947", true},
+		{"NO", CheckNorway, norway, "86011117948", false},
+		{"NO", CheckNorway, norway, "8601111794", false},
+		{"FI", CheckFinland, bban.Structure{}, "12345600000785", true},
+		{"FI", CheckFinland, bban.Structure{}, "12345600000786", false},
+		{"FI", CheckFinland, bban.Structure{}, "1234560000078A", false},
+		{"EE", CheckEstonia, estonia, "2200221020145685", true},
+		{"EE", CheckEstonia, estonia, "2200221020145686", false},
+		{"IS", CheckIceland, iceland, "0159260076545510730339", true},
+		{"IS", CheckIceland, iceland, "0159260076545510730349", false},
+	}
+
+	france = bban.NewStructure(
+		bban.NewBankCode(5, bban.Num),
+		bban.NewBranchCode(5, bban.Num),
+		bban.NewAccountNumber(11, bban.AlphaNum),
+		bban.NewNationalCheckDigit(2, bban.Num),
+	)
+	italy = bban.NewStructure(
+		bban.NewNationalCheckDigit(1, bban.AlphaUpper),
+		bban.NewBankCode(5, bban.Num),
+		bban.NewBranchCode(5, bban.Num),
+		bban.NewAccountNumber(12, bban.AlphaNum),
+	)
+	spain = bban.NewStructure(
+		bban.NewBankCode(4, bban.Num),
+		bban.NewBranchCode(4, bban.Num),
+		bban.NewNationalCheckDigit(2, bban.Num),
+		bban.NewAccountNumber(10, bban.Num),
+	)
+	czechSlovakia = bban.NewStructure(
+		bban.NewBankCode(4, bban.Num),
+		bban.NewAccountNumberPrefix(6, bban.Num),
+		bban.NewAccountNumber(10, bban.Num),
+	)
+	poland = bban.NewStructure(
+		bban.NewBankCode(3, bban.Num),
+		bban.NewBranchCode(4, bban.Num),
+		bban.NewNationalCheckDigit(1, bban.Num),
+		bban.NewAccountNumber(16, bban.Num),
+	)
+	croatia = bban.NewStructure(
+		bban.NewBankCode(7, bban.Num),
+		bban.NewAccountNumber(10, bban.Num),
+	)
+	norway = bban.NewStructure(
+		bban.NewBankCode(4, bban.Num),
+		bban.NewAccountNumber(6, bban.Num),
+		bban.NewNationalCheckDigit(1, bban.Num),
+	)
+	estonia = bban.NewStructure(
+		bban.NewBankCode(2, bban.Num),
+		bban.NewBranchCode(2, bban.Num),
+		bban.NewAccountNumber(11, bban.Num),
+		bban.NewNationalCheckDigit(1, bban.Num),
+	)
+	iceland = bban.NewStructure(
+		bban.NewBankCode(4, bban.Num),
+		bban.NewBranchCode(2, bban.Num),
+		bban.NewAccountNumber(6, bban.Num),
+		bban.NewIdentificationNumber(10, bban.Num),
+	)
+	belgium = bban.NewStructure(
+		bban.NewBankCode(3, bban.Num),
+		bban.NewAccountNumber(7, bban.Num),
+		bban.NewNationalCheckDigit(2, bban.Num),
+	)
+)
+
+func TestMod97(t *testing.T) {
+	cases := []struct {
+		value string
+		want  int
+		ok    bool
+	}{
+		{"539007547034BE00", 30, true},
+		{"539007547034be00", 30, true},
+		{"370400440532013000DE89", 1, true},
+		{"12-4", 0, false},
+		{"", 0, true},
+	}
+	for _, cs := range cases {
+		t.Run(cs.value, func(t *testing.T) {
+			mod, ok := Mod97(cs.value)
+			require.Equal(t, cs.ok, ok)
+			require.Equal(t, cs.want, mod)
+		})
+	}
+}
+
+func TestRIBKey(t *testing.T) {
+	cases := []struct {
+		bank    string
+		branch  string
+		account string
+		want    string
+	}{
+		{"20041", "01005", "0500013M026", "06"},
+		{"30006", "00001", "12345678901", "89"},
+		{"11222", "00001", "01234567890", "30"},
+		{"30002", "00550", "0000157845Z", "02"},
+	}
+	for _, cs := range cases {
+		t.Run(cs.account, func(t *testing.T) {
+			key, err := RIBKey(cs.bank, cs.branch, cs.account)
+			require.NoError(t, err)
+			require.Equal(t, cs.want, key)
+		})
+	}
+}
+
+func TestRIBKeyInvalid(t *testing.T) {
+	_, err := RIBKey("20041", "01005", "0500013m026")
+	require.Equal(t, ErrInvalidValue, err)
+
+	_, err = RIBKey("", "01005", "0500013M026")
+	require.Equal(t, ErrInvalidValue, err)
+}
+
+func TestCIN(t *testing.T) {
+	cases := []struct {
+		abi     string
+		cab     string
+		account string
+		want    string
+	}{
+		{"05428", "11101", "000000123456", "X"},
+		{"03225", "09800", "000000270100", "U"},
+		{"03069", "09606", "100000063112", "H"},
+	}
+	for _, cs := range cases {
+		t.Run(cs.account, func(t *testing.T) {
+			cin, err := CIN(cs.abi, cs.cab, cs.account)
+			require.NoError(t, err)
+			require.Equal(t, cs.want, cin)
+		})
+	}
+}
+
+func TestCINInvalid(t *testing.T) {
+	_, err := CIN("05428", "11101", "00000012345-")
+	require.Equal(t, ErrInvalidValue, err)
+
+	_, err = CIN("05428", "", "000000123456")
+	require.Equal(t, ErrInvalidValue, err)
+}
+
+func TestSpainControlDigits(t *testing.T) {
+	cases := []struct {
+		entity  string
+		office  string
+		account string
+		want    string
+	}{
+		{"2100", "0418", "0200051332", "45"},
+		{"0000", "0000", "0000000000", "00"},
+		{"0008", "2000", "15", "10"},
+		{"0000", "0000", "2", "01"},
+	}
+	for _, cs := range cases {
+		t.Run(cs.entity+cs.office+cs.account, func(t *testing.T) {
+			dc, err := SpainControlDigits(cs.entity, cs.office, cs.account)
+			require.NoError(t, err)
+			require.Equal(t, cs.want, dc)
+		})
+	}
+}
+
+func TestSpainControlDigitsInvalid(t *testing.T) {
+	_, err := SpainControlDigits("2100", "0418", "02000513321")
+	require.Equal(t, ErrInvalidValue, err)
+
+	_, err = SpainControlDigits("21A0", "0418", "0200051332")
+	require.Equal(t, ErrInvalidValue, err)
+
+	_, err = SpainControlDigits("2100", "0418", "")
+	require.Equal(t, ErrInvalidValue, err)
+}
+
+func TestCheckers(t *testing.T) {
+	for _, cs := range checkerCases {
+		t.Run(cs.name+cs.bban, func(t *testing.T) {
+			require.Equal(t, cs.want, cs.checker(cs.bban, cs.struc))
+		})
+	}
+}
+
+func TestLookupChecker(t *testing.T) {
+	checker, ok := LookupChecker("CheckBelgium")
+	require.True(t, ok)
+	require.True(t, checker("539007547034", belgium))
+	require.False(t, checker("539007547035", belgium))
+
+	_, ok = LookupChecker("CheckMars")
+	require.False(t, ok)
+}
@@ -0,0 +1,132 @@
+// Package nl provides validation of dutch account numbers using elfproef
+// (weighted modulo 11) and conversion of legacy dutch account numbers to
+// iban, both driven by a local bank code table.
+package nl
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jbub/banking/iban"
+)
+
+// countryCode represents country code of dutch iban.
+const countryCode = "NL"
+
+// Error codes returned by failures to validate an account number.
+var (
+	ErrNotDutch             = errors.New("nl: iban is not dutch")
+	ErrBankCodeNotPresent   = errors.New("nl: bank code does not exist")
+	ErrInvalidAccountNumber = errors.New("nl: invalid account number")
+)
+
+const (
+	// accountLength represents length of account number in iban.
+	accountLength = 10
+
+	// maxGiroLength represents maximal length of former Postbank giro number.
+	maxGiroLength = 7
+
+	// minBankAccountLength represents minimal length of legacy bank account number.
+	minBankAccountLength = 9
+)
+
+// Validator validates dutch account numbers.
+type Validator struct {
+	table *Table
+}
+
+// NewValidator creates a new Validator using given bank code table.
+func NewValidator(table *Table) *Validator {
+	return &Validator{table: table}
+}
+
+// Validate validates account number of dutch iban.
+func (v *Validator) Validate(ibn *iban.Iban) error {
+	if ibn.CountryCode() != countryCode {
+		return ErrNotDutch
+	}
+	return v.ValidateAccount(ibn.BankCode(), ibn.AccountNumber())
+}
+
+// ValidateAccount validates account number with given bank code. Account
+// numbers shorter than ten digits are padded with leading zeros.
+func (v *Validator) ValidateAccount(bankCode string, account string) error {
+	bank, ok := v.table.Lookup(bankCode)
+	if !ok {
+		return ErrBankCodeNotPresent
+	}
+	if account == "" || len(account) > accountLength || !isNumeric(account) {
+		return ErrInvalidAccountNumber
+	}
+
+	account = strings.Repeat("0", accountLength-len(account)) + account
+	switch bank.Check {
+	case CheckNone:
+		return nil
+	case CheckPostbank:
+		if isGiro(account) {
+			return nil
+		}
+	}
+
+	if !checkElfproef(account) {
+		return ErrInvalidAccountNumber
+	}
+	return nil
+}
+
+// Convert converts legacy dutch account number held at bank with given
+// bank code to iban. Spaces and dots are removed from account number,
+// giro numbers of up to seven digits are accepted only for banks which
+// took over Postbank accounts, bank account numbers have nine or ten digits.
+func (v *Validator) Convert(bankCode string, account string, opts ...iban.Option) (*iban.Iban, error) {
+	bank, ok := v.table.Lookup(bankCode)
+	if !ok {
+		return nil, ErrBankCodeNotPresent
+	}
+
+	account = strings.NewReplacer(" ", "", ".", "").Replace(account)
+	account = strings.TrimLeft(account, "0")
+	switch n := len(account); {
+	case n == 0:
+		return nil, ErrInvalidAccountNumber
+	case n <= maxGiroLength:
+		if bank.Check != CheckPostbank {
+			return nil, ErrInvalidAccountNumber
+		}
+	case n < minBankAccountLength:
+		return nil, ErrInvalidAccountNumber
+	}
+
+	if err := v.ValidateAccount(bankCode, account); err != nil {
+		return nil, err
+	}
+
+	account = strings.Repeat("0", accountLength-len(account)) + account
+	return iban.FromBban(countryCode, bankCode+account, opts...)
+}
+
+// checkElfproef returns true if weighted sum of account number digits
+// with weights 10 to 1 is divisible by 11.
+func checkElfproef(account string) bool {
+	var sum int
+	for idx, c := range account {
+		sum += int(c-'0') * (accountLength - idx)
+	}
+	return sum > 0 && sum%11 == 0
+}
+
+func isGiro(account string) bool {
+	n := len(strings.TrimLeft(account, "0"))
+	return n > 0 && n <= maxGiroLength
+}
+
+func isNumeric(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
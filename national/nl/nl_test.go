@@ -0,0 +1,115 @@
+package nl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/iban"
+)
+
+var (
+	accountCases = []struct {
+		bankCode string
+		account  string
+		err      error
+	}{
+		{"ABNA", "0417164300", nil},
+		{"ABNA", "417164300", nil},
+		{"ABNA", "0417164301", ErrInvalidAccountNumber},
+		{"RABO", "0123456789", nil},
+		{"RABO", "0156750643", nil},
+		{"RABO", "0156750642", ErrInvalidAccountNumber},
+		{"RABO", "0000000000", ErrInvalidAccountNumber},
+		{"INGB", "0001234567", nil},
+		{"INGB", "0156750643", nil},
+		{"INGB", "0156750642", ErrInvalidAccountNumber},
+		{"KNAB", "0156750642", nil},
+		{"ABNA", "04171643000", ErrInvalidAccountNumber},
+		{"ABNA", "041716430O", ErrInvalidAccountNumber},
+		{"ABNA", "", ErrInvalidAccountNumber},
+		{"XXXX", "0417164300", ErrBankCodeNotPresent},
+	}
+
+	convertCases = []struct {
+		bankCode string
+		account  string
+		iban     string
+		err      error
+	}{
+		{"ABNA", "41.71.64.300", "NL91ABNA0417164300", nil},
+		{"ABNA", "0417164300", "NL91ABNA0417164300", nil},
+		{"INGB", "1234567", "NL20INGB0001234567", nil},
+		{"INGB", "123 45 67", "NL20INGB0001234567", nil},
+		{"RABO", "156750643", "NL03RABO0156750643", nil},
+		{"RABO", "156750642", "", ErrInvalidAccountNumber},
+		{"RABO", "1234567", "", ErrInvalidAccountNumber},
+		{"ABNA", "12345678", "", ErrInvalidAccountNumber},
+		{"ABNA", "0", "", ErrInvalidAccountNumber},
+		{"ABNA", "41716430012", "", ErrInvalidAccountNumber},
+		{"XXXX", "417164300", "", ErrBankCodeNotPresent},
+	}
+)
+
+func loadTable(t *testing.T) *Table {
+	table, err := LoadTableFile("testdata/banks.txt")
+	require.NoError(t, err)
+	return table
+}
+
+func TestLoadTable(t *testing.T) {
+	table := loadTable(t)
+	require.Equal(t, 5, table.Len())
+
+	bank, ok := table.Lookup("INGB")
+	require.True(t, ok)
+	require.Equal(t, Bank{Code: "INGB", Name: "ING Bank", Check: CheckPostbank}, bank)
+
+	_, ok = table.Lookup("XXXX")
+	require.False(t, ok)
+}
+
+func TestLoadTableInvalid(t *testing.T) {
+	for _, record := range []string{"ABNA", "ABN elfproef", "ABNa elfproef", "ABNA mod11 ABN AMRO"} {
+		_, err := LoadTable(strings.NewReader(record))
+		require.Equal(t, ErrInvalidRecord, err, record)
+	}
+
+	_, err := LoadTableFile("testdata/missing.txt")
+	require.Error(t, err)
+}
+
+func TestValidateAccount(t *testing.T) {
+	v := NewValidator(loadTable(t))
+	for _, cs := range accountCases {
+		t.Run(cs.bankCode+cs.account, func(t *testing.T) {
+			require.Equal(t, cs.err, v.ValidateAccount(cs.bankCode, cs.account))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	v := NewValidator(loadTable(t))
+	require.NoError(t, v.Validate(iban.MustParse("NL91ABNA0417164300")))
+	require.Equal(t, ErrNotDutch, v.Validate(iban.MustParse("BE68539007547034")))
+
+	ibn, err := iban.FromBban("NL", "ABNA0417164301")
+	require.NoError(t, err)
+	require.Equal(t, ErrInvalidAccountNumber, v.Validate(ibn))
+}
+
+func TestConvert(t *testing.T) {
+	v := NewValidator(loadTable(t))
+	for _, cs := range convertCases {
+		t.Run(cs.bankCode+cs.account, func(t *testing.T) {
+			ibn, err := v.Convert(cs.bankCode, cs.account)
+			if cs.err != nil {
+				require.Equal(t, cs.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, cs.iban, ibn.String())
+		})
+	}
+}
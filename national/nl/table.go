@@ -0,0 +1,115 @@
+package nl
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// Error codes returned by failures to load bank code table.
+var (
+	ErrInvalidRecord = errors.New("nl: invalid bank code table record")
+)
+
+// Check represents account number check applied by a bank.
+type Check string
+
+// Account number checks.
+const (
+	// CheckElfproef applies elfproef to all account numbers.
+	CheckElfproef Check = "elfproef"
+
+	// CheckPostbank applies elfproef to bank account numbers only, former
+	// Postbank giro numbers of up to seven digits are not checked.
+	CheckPostbank Check = "postbank"
+
+	// CheckNone does not check account numbers.
+	CheckNone Check = "none"
+)
+
+// bankCodeLength represents length of bank code.
+const bankCodeLength = 4
+
+// Bank holds info about a bank code loaded from bank code table.
+type Bank struct {
+	Code  string
+	Name  string
+	Check Check
+}
+
+// Table holds banks keyed by bank code.
+type Table struct {
+	banks map[string]Bank
+}
+
+// Lookup returns Bank by given bank code.
+func (t *Table) Lookup(code string) (Bank, bool) {
+	bank, ok := t.banks[code]
+	return bank, ok
+}
+
+// Len returns number of bank codes in table.
+func (t *Table) Len() int {
+	return len(t.banks)
+}
+
+// LoadTable loads bank code table. Each record consists of bank code,
+// account number check and bank name separated by whitespace, empty lines
+// and lines starting with # are skipped.
+func LoadTable(r io.Reader) (*Table, error) {
+	table := &Table{banks: make(map[string]Bank)}
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !isBankCode(fields[0]) {
+			return nil, ErrInvalidRecord
+		}
+
+		bank := Bank{
+			Code:  fields[0],
+			Name:  strings.Join(fields[2:], " "),
+			Check: Check(fields[1]),
+		}
+		switch bank.Check {
+		case CheckElfproef, CheckPostbank, CheckNone:
+		default:
+			return nil, ErrInvalidRecord
+		}
+		table.banks[bank.Code] = bank
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// LoadTableFile loads bank code table from given file.
+func LoadTableFile(path string) (*Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadTable(f)
+}
+
+func isBankCode(value string) bool {
+	if len(value) != bankCodeLength {
+		return false
+	}
+	for _, c := range value {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
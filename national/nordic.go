@@ -0,0 +1,107 @@
+package national
+
+import (
+	"github.com/jbub/banking/bban"
+)
+
+const (
+	// mod11 represents modulo used in weighted modulo 11 checks.
+	mod11 = 11
+
+	// luhnDoubleMax represents maximal doubled digit in Luhn algorithm.
+	luhnDoubleMax = 9
+)
+
+var (
+	// norwayWeights holds weights of Norwegian bank code and account number.
+	norwayWeights = []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+
+	// estoniaWeights holds weights of Estonian account number.
+	estoniaWeights = []int{7, 3, 1}
+
+	// icelandWeights holds weights of Icelandic kennitala.
+	icelandWeights = []int{3, 2, 7, 6, 5, 4, 3, 2}
+)
+
+// CheckNorway validates Norwegian bban, national check digit is calculated
+// from bank code and account number using weighted modulo 11.
+func CheckNorway(bbn string, struc bban.Structure) bool {
+	digit := mod11Digit(struc.Extract(bbn, bban.BankCode)+struc.Extract(bbn, bban.AccountNumber), norwayWeights)
+	return digit >= 0 && padDigits(digit, 1) == struc.Extract(bbn, bban.NationalCheckDigit)
+}
+
+// CheckFinland validates Finnish bban using Luhn algorithm.
+func CheckFinland(bbn string, _ bban.Structure) bool {
+	return checkLuhn(bbn)
+}
+
+// CheckEstonia validates Estonian bban, national check digit is calculated
+// from branch code and account number using repeated 7, 3, 1 weights applied
+// from right to left.
+func CheckEstonia(bbn string, struc bban.Structure) bool {
+	value := struc.Extract(bbn, bban.BranchCode) + struc.Extract(bbn, bban.AccountNumber)
+	if value == "" {
+		return false
+	}
+
+	var sum int
+	for idx := len(value) - 1; idx >= 0; idx-- {
+		c := value[idx]
+		if c < '0' || c > '9' {
+			return false
+		}
+		sum += int(c-'0') * estoniaWeights[(len(value)-1-idx)%len(estoniaWeights)]
+	}
+	return padDigits((mod10-sum%mod10)%mod10, 1) == struc.Extract(bbn, bban.NationalCheckDigit)
+}
+
+// CheckIceland validates Icelandic bban, the ninth digit of kennitala stored
+// in identification number is its check digit.
+func CheckIceland(bbn string, struc bban.Structure) bool {
+	id := struc.Extract(bbn, bban.IdentificationNumber)
+	if len(id) <= len(icelandWeights) {
+		return false
+	}
+
+	digit := mod11Digit(id[:len(icelandWeights)], icelandWeights)
+	return digit >= 0 && padDigits(digit, 1) == id[len(icelandWeights):len(icelandWeights)+1]
+}
+
+// mod11Digit calculates weighted modulo 11 check digit of value, remainder
+// 0 gives check digit 0. Returns -1 if value is invalid or check digit
+// would be 10.
+func mod11Digit(value string, weights []int) int {
+	mod := weightedMod(value, weights, mod11)
+	switch mod {
+	case -1, 1:
+		return -1
+	case 0:
+		return 0
+	}
+	return mod11 - mod
+}
+
+// checkLuhn validates numeric value ending with Luhn check digit.
+func checkLuhn(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	var sum int
+	for idx := len(value) - 1; idx >= 0; idx-- {
+		c := value[idx]
+		if c < '0' || c > '9' {
+			return false
+		}
+
+		d := int(c - '0')
+		if (len(value)-1-idx)%2 == 1 {
+			d *= 2
+			if d > luhnDoubleMax {
+				d -= luhnDoubleMax
+			}
+		}
+		sum += d
+	}
+	return sum%mod10 == 0
+}
@@ -0,0 +1,76 @@
+package national
+
+import (
+	"github.com/jbub/banking/bban"
+)
+
+const (
+	// ribBankWeight represents weight of bank code in RIB key.
+	ribBankWeight = 89
+
+	// ribBranchWeight represents weight of branch code in RIB key.
+	ribBranchWeight = 15
+
+	// ribAccountWeight represents weight of account number in RIB key.
+	ribAccountWeight = 3
+
+	// ribKeyLength represents length of RIB key.
+	ribKeyLength = 2
+)
+
+// RIBKey calculates French and Monegasque RIB key (clé RIB) from bank code,
+// branch code and account number. Letters in account number are converted
+// to digits using the RIB conversion table.
+func RIBKey(bank string, branch string, account string) (string, error) {
+	if bank == "" || branch == "" || account == "" {
+		return "", ErrInvalidValue
+	}
+
+	b, ok := ribNumber(bank)
+	if !ok {
+		return "", ErrInvalidValue
+	}
+	br, ok := ribNumber(branch)
+	if !ok {
+		return "", ErrInvalidValue
+	}
+	a, ok := ribNumber(account)
+	if !ok {
+		return "", ErrInvalidValue
+	}
+
+	sum := (ribBankWeight*b + ribBranchWeight*br + ribAccountWeight*a) % mod97
+	return padDigits(int(mod97-sum), ribKeyLength), nil
+}
+
+// CheckFrance validates RIB key of French and Monegasque bban.
+func CheckFrance(bbn string, struc bban.Structure) bool {
+	key, err := RIBKey(
+		struc.Extract(bbn, bban.BankCode),
+		struc.Extract(bbn, bban.BranchCode),
+		struc.Extract(bbn, bban.AccountNumber),
+	)
+	return err == nil && key == struc.Extract(bbn, bban.NationalCheckDigit)
+}
+
+// ribNumber converts value to number modulo 97 using RIB conversion table.
+func ribNumber(value string) (int64, bool) {
+	var total int64
+	for _, c := range value {
+		var n int64
+		switch {
+		case '0' <= c && c <= '9':
+			n = int64(c - '0')
+		case 'A' <= c && c <= 'I':
+			n = int64(c-'A') + 1
+		case 'J' <= c && c <= 'R':
+			n = int64(c-'J') + 1
+		case 'S' <= c && c <= 'Z':
+			n = int64(c-'S') + 2
+		default:
+			return 0, false
+		}
+		total = (total*10 + n) % mod97
+	}
+	return total, true
+}
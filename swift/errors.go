@@ -0,0 +1,48 @@
+package swift
+
+import "strconv"
+
+// Code represents a stable machine readable code of validation error.
+type Code string
+
+// Codes of validation errors.
+const (
+	CodeInvalidLength         Code = "SWIFT_LENGTH_INVALID"
+	CodeInvalidCase           Code = "SWIFT_CASE_INVALID"
+	CodeInvalidBankCode       Code = "SWIFT_BANK_CODE_INVALID"
+	CodeInvalidCountryCode    Code = "SWIFT_COUNTRY_CODE_INVALID"
+	CodeCountryCodeNotPresent Code = "SWIFT_COUNTRY_CODE_NOT_PRESENT"
+	CodeInvalidLocationCode   Code = "SWIFT_LOCATION_CODE_INVALID"
+	CodeInvalidBranchCode     Code = "SWIFT_BRANCH_CODE_INVALID"
+)
+
+// ValidationError describes a failure to validate a swift code. It wraps one
+// of the Err* sentinel errors, so errors.Is can be used to check for them.
+type ValidationError struct {
+	// Code is a machine readable code of the error.
+	Code Code
+
+	// Offset is a byte offset of the failing character or part in swift code.
+	Offset int
+
+	// Err is the underlying sentinel error.
+	Err error
+}
+
+// Error returns text representation of ValidationError.
+func (e *ValidationError) Error() string {
+	return e.Err.Error() + " at offset " + strconv.Itoa(e.Offset)
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+func newError(code Code, offset int, err error) *ValidationError {
+	return &ValidationError{
+		Code:   code,
+		Offset: offset,
+		Err:    err,
+	}
+}
@@ -0,0 +1,82 @@
+package swift
+
+import (
+	"github.com/jbub/banking/country"
+)
+
+// Parser parses and validates swift codes using countries held in a
+// country.Registry.
+type Parser struct {
+	reg *country.Registry
+}
+
+// defaultParser is used by package level functions.
+var defaultParser = NewParser(country.Default())
+
+// NewParser creates a new Parser using given registry.
+func NewParser(reg *country.Registry) *Parser {
+	return &Parser{reg: reg}
+}
+
+// Validate validates swift code.
+func (p *Parser) Validate(value string) error {
+	if err := validateLength(value); err != nil {
+		return err
+	}
+
+	if err := validateCase(value); err != nil {
+		return err
+	}
+
+	if err := validateBankCode(value); err != nil {
+		return err
+	}
+
+	if err := validateCountryCode(value, p.reg); err != nil {
+		return err
+	}
+
+	if err := validateLocationCode(value); err != nil {
+		return err
+	}
+
+	return validateBranchCode(value)
+}
+
+// ValidateAll validates swift code and returns all found validation errors,
+// nil is returned for valid swift code.
+func (p *Parser) ValidateAll(value string) []error {
+	var errs []error
+	if err := validateLength(value); err != nil {
+		if len(value) < lengthSwift8 {
+			return []error{err}
+		}
+		errs = append(errs, err)
+	}
+
+	validators := []func(string) error{
+		validateCase,
+		validateBankCode,
+		p.validateCountryCode,
+		validateLocationCode,
+		validateBranchCode,
+	}
+	for _, validator := range validators {
+		if err := validator(value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Parse validates and creates new swift code.
+func (p *Parser) Parse(value string) (*Swift, error) {
+	if err := p.Validate(value); err != nil {
+		return nil, err
+	}
+	return &Swift{value: value}, nil
+}
+
+func (p *Parser) validateCountryCode(value string) error {
+	return validateCountryCode(value, p.reg)
+}
@@ -60,43 +60,26 @@ func (s *Swift) Type() Type {
 	return Type8
 }
 
-// Validate validates swift code.
+// Validate validates swift code using default country registry.
 func Validate(value string) error {
-	if err := validateLength(value); err != nil {
-		return err
-	}
-
-	if err := validateCase(value); err != nil {
-		return err
-	}
-
-	if err := validateBankCode(value); err != nil {
-		return err
-	}
-
-	if err := validateCountryCode(value); err != nil {
-		return err
-	}
-
-	if err := validateLocationCode(value); err != nil {
-		return err
-	}
+	return defaultParser.Validate(value)
+}
 
-	return validateBranchCode(value)
+// ValidateAll validates swift code using default country registry and
+// returns all found validation errors, nil is returned for valid swift code.
+func ValidateAll(value string) []error {
+	return defaultParser.ValidateAll(value)
 }
 
 // New validates and creates new swift code.
 // Deprecated: Use Parse instead.
 func New(value string) (*Swift, error) {
-	if err := Validate(value); err != nil {
-		return nil, err
-	}
-	return &Swift{value: value}, nil
+	return Parse(value)
 }
 
-// Parse validates and creates new swift code.
+// Parse validates and creates new swift code using default country registry.
 func Parse(value string) (*Swift, error) {
-	return New(value)
+	return defaultParser.Parse(value)
 }
 
 // MustParse tries to create new swift code, panics on failure.
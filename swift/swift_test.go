@@ -1,9 +1,12 @@
 package swift
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/jbub/banking/country"
 )
 
 var (
@@ -133,7 +136,7 @@ func TestValidateBankCode(t *testing.T) {
 func TestValidateCountryCode(t *testing.T) {
 	for _, cs := range validCases {
 		t.Run(cs.swift, func(t *testing.T) {
-			err := validateCountryCode(cs.swift)
+			err := validateCountryCode(cs.swift, country.Default())
 			require.NoError(t, err)
 		})
 	}
@@ -176,7 +179,7 @@ func TestParseInvalid(t *testing.T) {
 		t.Run(cs.swift, func(t *testing.T) {
 			sw, err := Parse(cs.swift)
 			require.Nil(t, sw)
-			require.Equal(t, cs.err, err)
+			require.ErrorIs(t, err, cs.err)
 		})
 	}
 }
@@ -210,3 +213,82 @@ func BenchmarkValidate(b *testing.B) {
 		_ = Validate("DEUTDEFF500")
 	}
 }
+
+func TestValidationError(t *testing.T) {
+	cases := []struct {
+		swift  string
+		code   Code
+		offset int
+		msg    string
+	}{
+		{"KU78N78", CodeInvalidLength, 0, "swift: invalid length at offset 0"},
+		{"MK23MjK2", CodeInvalidCase, 5, "swift: invalid case at offset 5"},
+		{"MK23KDLF", CodeInvalidBankCode, 0, "swift: invalid bank code at offset 0"},
+		{"JMKM3KDL", CodeInvalidCountryCode, 4, "swift: invalid country code at offset 4"},
+		{"JMKMXXDL", CodeCountryCodeNotPresent, 4, "swift: country code does not exist at offset 4"},
+		{"JMKMSK--", CodeInvalidLocationCode, 6, "swift: invalid location code at offset 6"},
+		{"JMKMSKLDDS-", CodeInvalidBranchCode, 8, "swift: invalid branch code at offset 8"},
+	}
+	for _, cs := range cases {
+		t.Run(cs.swift, func(t *testing.T) {
+			err := Validate(cs.swift)
+			require.EqualError(t, err, cs.msg)
+
+			var verr *ValidationError
+			require.True(t, errors.As(err, &verr))
+			require.Equal(t, cs.code, verr.Code)
+			require.Equal(t, cs.offset, verr.Offset)
+		})
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	for _, cs := range validCases {
+		t.Run(cs.swift, func(t *testing.T) {
+			require.Empty(t, ValidateAll(cs.swift))
+		})
+	}
+}
+
+func TestValidateAllInvalid(t *testing.T) {
+	cases := []struct {
+		swift string
+		codes []Code
+	}{
+		{"KU78N78", []Code{CodeInvalidLength}},
+		{"DEUTDEFF5", []Code{CodeInvalidLength}},
+		{"D3UTXXF-", []Code{CodeInvalidBankCode, CodeCountryCodeNotPresent, CodeInvalidLocationCode}},
+		{"deutDEFF50-", []Code{CodeInvalidCase, CodeInvalidBankCode, CodeInvalidBranchCode}},
+	}
+	for _, cs := range cases {
+		t.Run(cs.swift, func(t *testing.T) {
+			errs := ValidateAll(cs.swift)
+			require.Len(t, errs, len(cs.codes))
+			for idx, err := range errs {
+				var verr *ValidationError
+				require.True(t, errors.As(err, &verr))
+				require.Equal(t, cs.codes[idx], verr.Code)
+			}
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	reg := country.NewRegistry()
+	p := NewParser(reg)
+
+	err := p.Validate("DEUTDEFF500")
+	require.ErrorIs(t, err, ErrCountryCodeNotPresent)
+
+	c, _ := country.Get("DE")
+	require.NoError(t, reg.Register(c))
+
+	swft, err := p.Parse("DEUTDEFF500")
+	require.NoError(t, err)
+	require.Equal(t, "DE", swft.CountryCode())
+	require.Empty(t, p.ValidateAll("DEUTDEFF500"))
+
+	errs := p.ValidateAll("DEUTSKBA")
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], ErrCountryCodeNotPresent)
+}
@@ -10,19 +10,31 @@ const (
 
 	// lengthSwift11 represents length of type Swift11 swift codes.
 	lengthSwift11 = 11
+
+	// bankCodeOffset represents offset of bank code in swift code.
+	bankCodeOffset = 0
+
+	// countryCodeOffset represents offset of country code in swift code.
+	countryCodeOffset = 4
+
+	// locationCodeOffset represents offset of location code in swift code.
+	locationCodeOffset = 6
+
+	// branchCodeOffset represents offset of branch code in swift code.
+	branchCodeOffset = 8
 )
 
 func validateLength(value string) error {
 	if l := len(value); l != lengthSwift8 && l != lengthSwift11 {
-		return ErrInvalidLength
+		return newError(CodeInvalidLength, 0, ErrInvalidLength)
 	}
 	return nil
 }
 
 func validateCase(value string) error {
-	for _, r := range value {
+	for idx, r := range value {
 		if 'a' <= r && r <= 'z' {
-			return ErrInvalidCase
+			return newError(CodeInvalidCase, idx, ErrInvalidCase)
 		}
 	}
 	return nil
@@ -30,26 +42,26 @@ func validateCase(value string) error {
 
 func validateBankCode(value string) error {
 	if bankCode := extractBankCode(value); !validateAlpha(bankCode) {
-		return ErrInvalidBankCode
+		return newError(CodeInvalidBankCode, bankCodeOffset, ErrInvalidBankCode)
 	}
 	return nil
 }
 
-func validateCountryCode(value string) error {
+func validateCountryCode(value string, reg *country.Registry) error {
 	code := extractCountryCode(value)
 	if !validateAlpha(code) {
-		return ErrInvalidCountryCode
+		return newError(CodeInvalidCountryCode, countryCodeOffset, ErrInvalidCountryCode)
 	}
 
-	if !country.Exists(code) {
-		return ErrCountryCodeNotPresent
+	if !reg.Exists(code) {
+		return newError(CodeCountryCodeNotPresent, countryCodeOffset, ErrCountryCodeNotPresent)
 	}
 	return nil
 }
 
 func validateLocationCode(value string) error {
 	if code := extractLocationCode(value); !validateAlphaNum(code) {
-		return ErrInvalidLocationCode
+		return newError(CodeInvalidLocationCode, locationCodeOffset, ErrInvalidLocationCode)
 	}
 	return nil
 }
@@ -60,25 +72,25 @@ func validateBranchCode(value string) error {
 	}
 
 	if code := extractBranchCode(value); !validateAlphaNum(code) {
-		return ErrInvalidBranchCode
+		return newError(CodeInvalidBranchCode, branchCodeOffset, ErrInvalidBranchCode)
 	}
 	return nil
 }
 
 func extractBankCode(value string) string {
-	return value[0:4]
+	return value[bankCodeOffset:countryCodeOffset]
 }
 
 func extractCountryCode(value string) string {
-	return value[4:6]
+	return value[countryCodeOffset:locationCodeOffset]
 }
 
 func extractLocationCode(value string) string {
-	return value[6:8]
+	return value[locationCodeOffset:branchCodeOffset]
 }
 
 func extractBranchCode(value string) string {
-	return value[8:11]
+	return value[branchCodeOffset:lengthSwift11]
 }
 
 func hasBranchCode(value string) bool {